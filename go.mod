@@ -5,7 +5,9 @@ go 1.25.5
 require (
 	connectrpc.com/connect v1.19.1
 	github.com/hashicorp/hcl/v2 v2.20.0
+	github.com/hashicorp/memberlist v0.5.2
 	github.com/hashicorp/serf v0.10.2
+	github.com/miekg/dns v1.1.56
 	github.com/spf13/cobra v1.8.0
 	github.com/stretchr/testify v1.11.1
 	golang.org/x/net v0.49.0
@@ -27,9 +29,7 @@ require (
 	github.com/hashicorp/go-multierror v1.1.1 // indirect
 	github.com/hashicorp/go-sockaddr v1.0.5 // indirect
 	github.com/hashicorp/golang-lru v1.0.2 // indirect
-	github.com/hashicorp/memberlist v0.5.2 // indirect
 	github.com/inconshreveable/mousetrap v1.1.0 // indirect
-	github.com/miekg/dns v1.1.56 // indirect
 	github.com/mitchellh/go-wordwrap v1.0.1 // indirect
 	github.com/pmezard/go-difflib v1.0.0 // indirect
 	github.com/sean-/seed v0.0.0-20170313163322-e2103e2c3529 // indirect
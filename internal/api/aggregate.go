@@ -0,0 +1,171 @@
+package api
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"sync"
+
+	"connectrpc.com/connect"
+	observerv1 "github.com/jumppad-labs/lattice/pkg/api/observer/v1"
+)
+
+const (
+	// defaultAggregateLimit is the number of merged logs returned when the
+	// request does not set a limit
+	defaultAggregateLimit = 100
+
+	// aggregateFetchLimit is how many logs are fetched from a service in
+	// one call while paging through its buffer
+	aggregateFetchLimit = 500
+
+	// aggregateConcurrency bounds how many services are queried at once
+	aggregateConcurrency = 8
+)
+
+// serviceLogs is the result of fetching logs from one service
+type serviceLogs struct {
+	service string
+	logs    []*observerv1.RequestLog
+	latest  uint64
+	err     error
+	route   *Route
+}
+
+// GetAggregatedRequestLogs fetches request logs from several services
+// concurrently and merges them by timestamp. Services that fail are
+// reported in the response rather than failing the call.
+func (s *ObserverService) GetAggregatedRequestLogs(
+	ctx context.Context,
+	req *connect.Request[observerv1.GetAggregatedRequestLogsRequest],
+) (*connect.Response[observerv1.GetAggregatedRequestLogsResponse], error) {
+	if err := validateLogFilter(req.Msg.Filter); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	limit := int(req.Msg.Limit)
+	if limit <= 0 {
+		limit = defaultAggregateLimit
+	}
+
+	services := req.Msg.ServiceNames
+	if len(services) == 0 {
+		services = serviceNames(s.router.topology())
+	}
+
+	results := make([]serviceLogs, len(services))
+	sem := make(chan struct{}, aggregateConcurrency)
+
+	var wg sync.WaitGroup
+	for i, service := range services {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			results[i] = fetchServiceLogs(ctx, s.fetchRequestLogs, service)
+		}()
+	}
+	wg.Wait()
+
+	return connect.NewResponse(mergeServiceLogs(results, req.Msg.Filter, limit)), nil
+}
+
+// fetchServiceLogs pages through all the logs a service holds, so filters
+// and start times apply to its whole buffer rather than to one batch.
+// Paging stops at the latest sequence reported by the first batch, so a
+// busy service can't keep the call going.
+func fetchServiceLogs(
+	ctx context.Context,
+	fetch func(ctx context.Context, service string, afterSequence uint64, limit int32) (*observerv1.GetRequestLogsResponse, *Route, error),
+	service string,
+) serviceLogs {
+	result := serviceLogs{service: service}
+
+	var after, end uint64
+	for {
+		resp, route, err := fetch(ctx, service, after, aggregateFetchLimit)
+		result.route = route
+		if err != nil {
+			return serviceLogs{service: service, err: err, route: route}
+		}
+		if end == 0 {
+			end = resp.LatestSequence
+		}
+		result.latest = resp.LatestSequence
+
+		start := after
+		for _, entry := range resp.Logs {
+			if entry.Sequence > after {
+				result.logs = append(result.logs, entry)
+				after = entry.Sequence
+			}
+		}
+
+		// A full batch with nothing new means the service isn't paging by
+		// sequence, asking again would return the same batch
+		if len(resp.Logs) < aggregateFetchLimit || after == start || after >= end {
+			return result
+		}
+	}
+}
+
+// mergeServiceLogs filters and merges per-service results, keeping the most
+// recent limit logs in timestamp order
+func mergeServiceLogs(
+	results []serviceLogs,
+	filter *observerv1.RequestLogFilter,
+	limit int,
+) *observerv1.GetAggregatedRequestLogsResponse {
+	resp := &observerv1.GetAggregatedRequestLogsResponse{
+		LatestSequences: make(map[string]uint64),
+	}
+
+	for _, result := range results {
+		if result.err != nil {
+			err := routerError(result.err, result.route)
+			resp.Errors = append(resp.Errors, &observerv1.ServiceLogError{
+				ServiceName: result.service,
+				Code:        connect.CodeOf(err).String(),
+				Message:     result.err.Error(),
+			})
+			continue
+		}
+
+		resp.LatestSequences[result.service] = result.latest
+		for _, entry := range result.logs {
+			if matchRequestLog(filter, entry) {
+				resp.Logs = append(resp.Logs, &observerv1.ServiceRequestLog{
+					ServiceName: result.service,
+					Log:         entry,
+				})
+			}
+		}
+	}
+
+	slices.SortStableFunc(resp.Logs, func(a, b *observerv1.ServiceRequestLog) int {
+		return cmp.Or(
+			cmp.Compare(a.Log.Timestamp, b.Log.Timestamp),
+			cmp.Compare(a.ServiceName, b.ServiceName),
+			cmp.Compare(a.Log.Sequence, b.Log.Sequence),
+		)
+	})
+	if len(resp.Logs) > limit {
+		resp.Logs = resp.Logs[len(resp.Logs)-limit:]
+	}
+
+	return resp
+}
+
+// serviceNames returns the distinct service names in a topology
+func serviceNames(topology *observerv1.Topology) []string {
+	var names []string
+	for _, svc := range topology.Services {
+		if !slices.Contains(names, svc.Name) {
+			names = append(names, svc.Name)
+		}
+	}
+	return names
+}
@@ -0,0 +1,134 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"connectrpc.com/connect"
+	observerv1 "github.com/jumppad-labs/lattice/pkg/api/observer/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergeServiceLogs(t *testing.T) {
+	results := []serviceLogs{
+		{service: "api-gateway", logs: []*observerv1.RequestLog{
+			{Sequence: 1, Timestamp: 100, Path: "/orders", Status: 200},
+			{Sequence: 2, Timestamp: 300, Path: "/orders", Status: 502},
+		}},
+		{service: "order-svc", logs: []*observerv1.RequestLog{
+			{Sequence: 9, Timestamp: 200, Path: "/orders", Status: 200},
+			{Sequence: 10, Timestamp: 250, Path: "/health", Status: 200},
+		}},
+		{service: "user-service", err: errors.New("connection refused")},
+		{service: "missing", err: ErrServiceNotFound},
+	}
+
+	resp := mergeServiceLogs(results, &observerv1.RequestLogFilter{PathPrefix: "/orders"}, 10)
+
+	var got []string
+	for _, entry := range resp.Logs {
+		got = append(got, entry.ServiceName)
+	}
+	require.Equal(t, []string{"api-gateway", "order-svc", "api-gateway"}, got)
+
+	require.Len(t, resp.Errors, 2)
+	require.Len(t, resp.LatestSequences, 2)
+	require.Equal(t, "user-service", resp.Errors[0].ServiceName)
+	require.Equal(t, connect.CodeInternal.String(), resp.Errors[0].Code)
+	require.Equal(t, "missing", resp.Errors[1].ServiceName)
+	require.Equal(t, connect.CodeNotFound.String(), resp.Errors[1].Code)
+
+	// The most recent logs are kept
+	resp = mergeServiceLogs(results, nil, 2)
+	require.Len(t, resp.Logs, 2)
+	require.Equal(t, int64(250), resp.Logs[0].Log.Timestamp)
+	require.Equal(t, int64(300), resp.Logs[1].Log.Timestamp)
+}
+
+func TestFetchServiceLogs(t *testing.T) {
+	// upstream holds logs 1 to n and returns them limit at a time
+	upstream := func(n int) func(ctx context.Context, service string, afterSequence uint64, limit int32) (*observerv1.GetRequestLogsResponse, *Route, error) {
+		return func(ctx context.Context, service string, afterSequence uint64, limit int32) (*observerv1.GetRequestLogsResponse, *Route, error) {
+			resp := &observerv1.GetRequestLogsResponse{LatestSequence: uint64(n)}
+			for seq := afterSequence + 1; seq <= uint64(n) && len(resp.Logs) < int(limit); seq++ {
+				resp.Logs = append(resp.Logs, &observerv1.RequestLog{Sequence: seq, Timestamp: int64(seq)})
+			}
+			return resp, nil, nil
+		}
+	}
+
+	// Logs beyond the first batch are fetched as well
+	result := fetchServiceLogs(context.Background(), upstream(1200), "api")
+	require.NoError(t, result.err)
+	require.Len(t, result.logs, 1200)
+	require.Equal(t, uint64(1200), result.latest)
+
+	resp := mergeServiceLogs([]serviceLogs{result}, &observerv1.RequestLogFilter{StartTime: 101}, 2000)
+	require.Len(t, resp.Logs, 1100)
+
+	// Paging stops at the latest sequence when the call started
+	n := aggregateFetchLimit
+	growing := func(ctx context.Context, service string, afterSequence uint64, limit int32) (*observerv1.GetRequestLogsResponse, *Route, error) {
+		resp, route, err := upstream(n)(ctx, service, afterSequence, limit)
+		n += aggregateFetchLimit
+		return resp, route, err
+	}
+	result = fetchServiceLogs(context.Background(), growing, "api")
+	require.Len(t, result.logs, aggregateFetchLimit)
+
+	// A service that ignores after_sequence is fetched once more, not forever
+	fetches := 0
+	stale := func(ctx context.Context, service string, afterSequence uint64, limit int32) (*observerv1.GetRequestLogsResponse, *Route, error) {
+		fetches++
+		resp, route, err := upstream(aggregateFetchLimit)(ctx, service, 0, limit)
+		resp.LatestSequence = 2000
+		return resp, route, err
+	}
+	result = fetchServiceLogs(context.Background(), stale, "api")
+	require.Len(t, result.logs, aggregateFetchLimit)
+	require.Equal(t, 2, fetches)
+
+	failing := func(ctx context.Context, service string, afterSequence uint64, limit int32) (*observerv1.GetRequestLogsResponse, *Route, error) {
+		return nil, nil, ErrServiceNotFound
+	}
+	result = fetchServiceLogs(context.Background(), failing, "api")
+	require.ErrorIs(t, result.err, ErrServiceNotFound)
+	require.Empty(t, result.logs)
+}
+
+func TestObserverService_GetAggregatedRequestLogs(t *testing.T) {
+	a := &fakeMeta{}
+	router := newTestRouter(t, startMeta(t, a), "127.0.0.1:1")
+
+	svc := NewObserverService(router.mesh)
+	svc.router = router
+
+	resp, err := svc.GetAggregatedRequestLogs(context.Background(), connect.NewRequest(&observerv1.GetAggregatedRequestLogsRequest{
+		ServiceNames: []string{"target", "a-svc", "missing"},
+	}))
+	require.NoError(t, err)
+
+	require.Len(t, resp.Msg.Logs, 2)
+	services := []string{resp.Msg.Logs[0].ServiceName, resp.Msg.Logs[1].ServiceName}
+	require.ElementsMatch(t, []string{"target", "a-svc"}, services)
+
+	require.Equal(t, uint64(7), resp.Msg.LatestSequences["target"])
+
+	require.Len(t, resp.Msg.Errors, 1)
+	require.Equal(t, "missing", resp.Msg.Errors[0].ServiceName)
+	require.Equal(t, "not_found", resp.Msg.Errors[0].Code)
+
+	// All services in the topology are queried by default
+	resp, err = svc.GetAggregatedRequestLogs(context.Background(), connect.NewRequest(&observerv1.GetAggregatedRequestLogsRequest{
+		Filter: &observerv1.RequestLogFilter{Methods: []string{"GET"}},
+	}))
+	require.NoError(t, err)
+	require.Len(t, resp.Msg.Logs, 3) // b-svc is reached through a after its own address fails
+	require.Empty(t, resp.Msg.Errors)
+
+	_, err = svc.GetAggregatedRequestLogs(context.Background(), connect.NewRequest(&observerv1.GetAggregatedRequestLogsRequest{
+		Filter: &observerv1.RequestLogFilter{MinLevel: "fatal"},
+	}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
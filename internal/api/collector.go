@@ -0,0 +1,174 @@
+package api
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/jumppad-labs/lattice/internal/logstore"
+	"github.com/jumppad-labs/lattice/internal/metrics"
+	observerv1 "github.com/jumppad-labs/lattice/pkg/api/observer/v1"
+)
+
+// CollectLogs polls every service in the topology for new request logs
+// until ctx is done, appending them to the log store and recording them in
+// the metrics recorder, whichever are enabled
+func (s *ObserverService) CollectLogs(ctx context.Context, interval time.Duration) {
+	c := &logCollector{
+		store:   s.logStore,
+		metrics: s.metrics,
+		fetch:   s.fetchRequestLogs,
+		cursors: make(map[string]uint64),
+		failing: make(map[string]bool),
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		c.collect(ctx, serviceNames(s.router.topology()))
+
+		// Age limits apply even when nothing new was collected
+		if s.logStore != nil {
+			s.logStore.Prune(time.Now())
+		}
+		if s.metrics != nil {
+			s.metrics.Prune(time.Now())
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// logCollector copies request logs from services into the log store and
+// metrics recorder. Either may be nil.
+type logCollector struct {
+	store   *logstore.Store
+	metrics *metrics.Recorder
+	fetch   func(ctx context.Context, service string, afterSequence uint64, limit int32) (*observerv1.GetRequestLogsResponse, *Route, error)
+
+	mu      sync.Mutex
+	cursors map[string]uint64 // Last sequence collected per service
+	failing map[string]bool   // Services whose last collection failed
+}
+
+// collect fetches new logs from each service concurrently
+func (c *logCollector) collect(ctx context.Context, services []string) {
+	sem := make(chan struct{}, aggregateConcurrency)
+
+	var wg sync.WaitGroup
+	for _, service := range services {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			err := c.collectService(ctx, service)
+
+			c.mu.Lock()
+			defer c.mu.Unlock()
+
+			// Only log when a service starts or stops failing
+			if err != nil && !c.failing[service] && ctx.Err() == nil {
+				log.Printf("Failed to collect request logs from %s: %v", service, err)
+			} else if err == nil && c.failing[service] {
+				log.Printf("Collecting request logs from %s again", service)
+			}
+			c.failing[service] = err != nil
+		}()
+	}
+	wg.Wait()
+}
+
+// collectService fetches and stores all logs after the last one collected
+// from a service
+func (c *logCollector) collectService(ctx context.Context, service string) error {
+	c.mu.Lock()
+	after, ok := c.cursors[service]
+	c.mu.Unlock()
+
+	if !ok && c.store != nil {
+		// Continue from what is already on disk
+		after, _ = c.store.LastSequence(service)
+	}
+
+	for {
+		start := after
+		resp, _, err := c.fetch(ctx, service, after, logBatchSize)
+		if err != nil {
+			return err
+		}
+
+		if resp.LatestSequence < after {
+			// The service restarted and its sequences started over
+			after = 0
+			continue
+		}
+
+		logs := make([]*observerv1.RequestLog, 0, len(resp.Logs))
+		for _, entry := range resp.Logs {
+			if entry.Sequence <= after {
+				continue
+			}
+			logs = append(logs, entry)
+			after = entry.Sequence
+		}
+
+		if err := c.record(service, logs); err != nil {
+			return err
+		}
+
+		c.mu.Lock()
+		c.cursors[service] = after
+		c.mu.Unlock()
+
+		// A full batch with nothing new means the service isn't paging by
+		// sequence, asking again would return the same batch
+		if len(resp.Logs) < logBatchSize || after == start {
+			return nil
+		}
+	}
+}
+
+// record passes newly collected logs to the store and metrics recorder
+func (c *logCollector) record(service string, logs []*observerv1.RequestLog) error {
+	if c.metrics != nil {
+		samples := make([]metrics.Sample, 0, len(logs))
+		for _, entry := range logs {
+			samples = append(samples, metrics.Sample{
+				Method:   entry.Method,
+				Path:     entry.Path,
+				Status:   entry.Status,
+				Duration: time.Duration(entry.DurationMs) * time.Millisecond,
+				Time:     time.UnixMilli(entry.Timestamp),
+			})
+		}
+		c.metrics.Record(service, samples, time.Now())
+	}
+
+	if c.store == nil {
+		return nil
+	}
+
+	entries := make([]logstore.Entry, 0, len(logs))
+	for _, entry := range logs {
+		entries = append(entries, logstore.Entry{
+			Service:    service,
+			Sequence:   entry.Sequence,
+			Timestamp:  entry.Timestamp,
+			Method:     entry.Method,
+			Path:       entry.Path,
+			Status:     entry.Status,
+			DurationMs: entry.DurationMs,
+			Level:      entry.Level,
+		})
+	}
+	return c.store.Append(entries)
+}
@@ -0,0 +1,141 @@
+package api
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jumppad-labs/lattice/internal/logstore"
+	"github.com/jumppad-labs/lattice/internal/metrics"
+	observerv1 "github.com/jumppad-labs/lattice/pkg/api/observer/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogCollector(t *testing.T) {
+	store, err := logstore.Open(logstore.Options{Dir: t.TempDir(), MaxBytes: 1 << 20})
+	require.NoError(t, err)
+	defer store.Close()
+
+	var (
+		mu       sync.Mutex
+		upstream = map[string][]*observerv1.RequestLog{
+			"api":    testLogs(1, 2, 3),
+			"orders": testLogs(1),
+		}
+	)
+	c := &logCollector{
+		store: store,
+		fetch: func(ctx context.Context, service string, afterSequence uint64, limit int32) (*observerv1.GetRequestLogsResponse, *Route, error) {
+			mu.Lock()
+			defer mu.Unlock()
+
+			logs, ok := upstream[service]
+			if !ok {
+				return nil, nil, ErrServiceNotFound
+			}
+
+			resp := &observerv1.GetRequestLogsResponse{}
+			for _, entry := range logs {
+				if entry.Sequence > afterSequence {
+					resp.Logs = append(resp.Logs, entry)
+				}
+				resp.LatestSequence = entry.Sequence
+			}
+			return resp, nil, nil
+		},
+		cursors: make(map[string]uint64),
+		failing: make(map[string]bool),
+	}
+
+	c.collect(context.Background(), []string{"api", "orders", "gone"})
+	require.True(t, c.failing["gone"])
+
+	entries, _, err := store.Search(logstore.Query{Limit: 100})
+	require.NoError(t, err)
+	require.Len(t, entries, 4)
+
+	// Only new logs are collected
+	mu.Lock()
+	upstream["api"] = testLogs(1, 2, 3, 4)
+	mu.Unlock()
+	c.collect(context.Background(), []string{"api", "orders"})
+
+	entries, _, err = store.Search(logstore.Query{Services: []string{"api"}, Limit: 100})
+	require.NoError(t, err)
+	require.Len(t, entries, 4)
+
+	// A restarted service is collected from the beginning again
+	mu.Lock()
+	upstream["orders"] = nil
+	mu.Unlock()
+	c.collect(context.Background(), []string{"orders"})
+	mu.Lock()
+	upstream["orders"] = testLogs(1)
+	mu.Unlock()
+	c.collect(context.Background(), []string{"orders"})
+
+	entries, _, err = store.Search(logstore.Query{Services: []string{"orders"}, Limit: 100})
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+}
+
+func TestLogCollectorMetrics(t *testing.T) {
+	recorder, err := metrics.NewRecorder(metrics.Options{Windows: []time.Duration{time.Minute}})
+	require.NoError(t, err)
+
+	now := time.Now().UnixMilli()
+	c := &logCollector{
+		metrics: recorder,
+		fetch: func(ctx context.Context, service string, afterSequence uint64, limit int32) (*observerv1.GetRequestLogsResponse, *Route, error) {
+			return &observerv1.GetRequestLogsResponse{
+				Logs: []*observerv1.RequestLog{
+					{Sequence: 1, Timestamp: now, Method: "GET", Path: "/", Status: 200, DurationMs: 5},
+					{Sequence: 2, Timestamp: now, Method: "GET", Path: "/", Status: 502, DurationMs: 50},
+				},
+				LatestSequence: 2,
+			}, nil, nil
+		},
+		cursors: make(map[string]uint64),
+		failing: make(map[string]bool),
+	}
+
+	// Without a log store, collection starts from the service's buffer and
+	// logs already collected are not counted twice
+	c.collect(context.Background(), []string{"api"})
+	c.collect(context.Background(), []string{"api"})
+
+	summary, ok := recorder.Summary("api", time.Minute, time.Now())
+	require.True(t, ok)
+	require.Equal(t, int64(2), summary.Requests)
+	require.Equal(t, int64(1), summary.Errors)
+}
+
+func TestLogCollectorStaleBatch(t *testing.T) {
+	// The upstream ignores after_sequence and always returns the same full
+	// batch, which is collected once without fetching again
+	logs := make([]*observerv1.RequestLog, logBatchSize)
+	for i := range logs {
+		logs[i] = &observerv1.RequestLog{Sequence: uint64(i + 1)}
+	}
+
+	fetches := 0
+	c := &logCollector{
+		fetch: func(ctx context.Context, service string, afterSequence uint64, limit int32) (*observerv1.GetRequestLogsResponse, *Route, error) {
+			fetches++
+			return &observerv1.GetRequestLogsResponse{Logs: logs, LatestSequence: logBatchSize}, nil, nil
+		},
+		cursors: make(map[string]uint64),
+		failing: make(map[string]bool),
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	require.NoError(t, c.collectService(ctx, "api"))
+	require.Equal(t, 2, fetches)
+	require.Equal(t, uint64(logBatchSize), c.cursors["api"])
+
+	require.NoError(t, c.collectService(ctx, "api"))
+	require.Equal(t, 3, fetches)
+}
@@ -0,0 +1,92 @@
+package api
+
+import (
+	"maps"
+	"slices"
+
+	observerv1 "github.com/jumppad-labs/lattice/pkg/api/observer/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// serviceKey identifies a service across topology snapshots. Service names
+// are only unique per node, so the node name is included.
+func serviceKey(svc *observerv1.Service) string {
+	return svc.NodeName + "/" + svc.Name
+}
+
+// diffTopology computes the changes from prev to next. A nil prev is treated
+// as an empty topology.
+func diffTopology(prev, next *observerv1.Topology) *observerv1.TopologyDelta {
+	delta := &observerv1.TopologyDelta{
+		Timestamp: next.GetTimestamp(),
+	}
+
+	prevServices := make(map[string]*observerv1.Service, len(prev.GetServices()))
+	for _, svc := range prev.GetServices() {
+		prevServices[serviceKey(svc)] = svc
+	}
+
+	seen := make(map[string]bool, len(next.GetServices()))
+	for _, svc := range next.GetServices() {
+		key := serviceKey(svc)
+		seen[key] = true
+
+		old, ok := prevServices[key]
+		if !ok {
+			delta.Added = append(delta.Added, svc)
+			continue
+		}
+
+		if fields := changedFields(old, svc); len(fields) > 0 {
+			delta.Changed = append(delta.Changed, &observerv1.ServiceChange{
+				Service: svc,
+				Fields:  fields,
+			})
+		}
+	}
+
+	for _, svc := range prev.GetServices() {
+		if !seen[serviceKey(svc)] {
+			delta.Removed = append(delta.Removed, svc)
+		}
+	}
+
+	return delta
+}
+
+// isEmptyDelta reports whether a delta contains no changes
+func isEmptyDelta(delta *observerv1.TopologyDelta) bool {
+	return len(delta.Added) == 0 && len(delta.Removed) == 0 && len(delta.Changed) == 0
+}
+
+// changedFields returns the names of the fields that differ between two
+// versions of the same service. rtt_ms, metrics and status_reason are
+// deliberately ignored: they drift continuously and comparing them would
+// publish an update for every gossip round. A new reason is still sent
+// along with any other change, including a change of status.
+func changedFields(a, b *observerv1.Service) []string {
+	var fields []string
+
+	if a.Type != b.Type {
+		fields = append(fields, "type")
+	}
+	if a.Address != b.Address {
+		fields = append(fields, "address")
+	}
+	if !slices.Equal(a.Upstreams, b.Upstreams) {
+		fields = append(fields, "upstreams")
+	}
+	if a.Status != b.Status {
+		fields = append(fields, "status")
+	}
+	if !maps.Equal(a.Tags, b.Tags) {
+		fields = append(fields, "tags")
+	}
+	if !slices.EqualFunc(a.Resources, b.Resources, func(x, y *observerv1.Resource) bool {
+		return proto.Equal(x, y)
+	}) {
+		fields = append(fields, "resources")
+	}
+
+	return fields
+}
@@ -0,0 +1,90 @@
+package api
+
+import (
+	"testing"
+
+	observerv1 "github.com/jumppad-labs/lattice/pkg/api/observer/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func testService(node, name string) *observerv1.Service {
+	return &observerv1.Service{
+		Name:     name,
+		Type:     "http",
+		Address:  "10.0.0.1:8080",
+		NodeName: node,
+		Status:   observerv1.ServiceStatus_SERVICE_STATUS_HEALTHY,
+		Tags:     map[string]string{"env": "test"},
+	}
+}
+
+func TestDiffTopology(t *testing.T) {
+	prev := &observerv1.Topology{
+		Services: []*observerv1.Service{
+			testService("node1", "api"),
+			testService("node1", "users"),
+			testService("node2", "orders"),
+		},
+	}
+
+	changed := testService("node1", "users")
+	changed.Status = observerv1.ServiceStatus_SERVICE_STATUS_UNHEALTHY
+	changed.Upstreams = []string{"orders"}
+
+	next := &observerv1.Topology{
+		Services: []*observerv1.Service{
+			testService("node1", "api"),
+			changed,
+			testService("node3", "billing"),
+		},
+		Timestamp: 42,
+	}
+
+	delta := diffTopology(prev, next)
+	require.Equal(t, int64(42), delta.Timestamp)
+
+	require.Len(t, delta.Added, 1)
+	require.Equal(t, "billing", delta.Added[0].Name)
+
+	require.Len(t, delta.Removed, 1)
+	require.Equal(t, "orders", delta.Removed[0].Name)
+
+	require.Len(t, delta.Changed, 1)
+	require.Equal(t, "users", delta.Changed[0].Service.Name)
+	require.Equal(t, []string{"upstreams", "status"}, delta.Changed[0].Fields)
+}
+
+func TestDiffTopologyFromNil(t *testing.T) {
+	next := &observerv1.Topology{
+		Services: []*observerv1.Service{testService("node1", "api")},
+	}
+
+	delta := diffTopology(nil, next)
+	require.Len(t, delta.Added, 1)
+	require.Empty(t, delta.Removed)
+	require.Empty(t, delta.Changed)
+}
+
+func TestDiffTopologySameNameDifferentNodes(t *testing.T) {
+	prev := &observerv1.Topology{
+		Services: []*observerv1.Service{testService("node1", "api")},
+	}
+	next := &observerv1.Topology{
+		Services: []*observerv1.Service{testService("node2", "api")},
+	}
+
+	delta := diffTopology(prev, next)
+	require.Len(t, delta.Added, 1)
+	require.Len(t, delta.Removed, 1)
+	require.False(t, isEmptyDelta(delta))
+}
+
+func TestChangedFields(t *testing.T) {
+	a := testService("node1", "api")
+	b := testService("node1", "api")
+	require.Empty(t, changedFields(a, b))
+
+	b.Address = "10.0.0.2:8080"
+	b.Tags = map[string]string{"env": "prod"}
+	require.Equal(t, []string{"address", "tags"}, changedFields(a, b))
+}
@@ -0,0 +1,187 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/serf/serf"
+	"github.com/jumppad-labs/lattice/internal/health"
+	latticeserf "github.com/jumppad-labs/lattice/internal/serf"
+	observerv1 "github.com/jumppad-labs/lattice/pkg/api/observer/v1"
+)
+
+const (
+	// healthSyncInterval is how often health check targets are refreshed
+	// from the mesh and error rates are re-evaluated
+	healthSyncInterval = time.Second
+
+	// DefaultHealthCheck is the key of the check applied to services without
+	// a check of their own
+	DefaultHealthCheck = "*"
+)
+
+// SetHealthChecks enables active health checks, keyed by service name. The
+// check under DefaultHealthCheck applies to all other services. Checks only
+// run while RunHealthChecks is running.
+func (s *ObserverService) SetHealthChecks(checks map[string]health.Check) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.healthChecks = checks
+	s.checker = health.NewChecker(s.scheduleNotify)
+}
+
+// SetPassiveHealth marks services degraded or unhealthy when their request
+// error rate is too high. It needs the metrics recorder set by SetMetrics.
+func (s *ObserverService) SetPassiveHealth(passive health.Passive) {
+	passive = passive.WithDefaults()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.passiveHealth = &passive
+}
+
+// RunHealthChecks keeps health checks in line with the services in the mesh
+// and publishes the topology when a service's health changes, until ctx is
+// done
+func (s *ObserverService) RunHealthChecks(ctx context.Context) {
+	ticker := time.NewTicker(healthSyncInterval)
+	defer ticker.Stop()
+
+	var passive map[string]health.Status
+	for {
+		topology := s.buildTopology()
+
+		if s.checker != nil {
+			s.checker.Sync(s.healthTargets(topology))
+		}
+
+		// Check results notify on their own, error rates drift so compare
+		// them between rounds
+		next := s.passiveStatuses(topology)
+		if !maps.Equal(passive, next) {
+			s.scheduleNotify()
+		}
+		passive = next
+
+		select {
+		case <-ctx.Done():
+			if s.checker != nil {
+				s.checker.Stop()
+			}
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// healthTargets returns a check target for each service on a live node that
+// has a check configured
+func (s *ObserverService) healthTargets(topology *observerv1.Topology) []health.Target {
+	nodeAddrs := make(map[string]string)
+	for _, member := range s.mesh.Members() {
+		// Serf already reports services on nodes that are down
+		if member.Status == serf.StatusAlive.String() {
+			nodeAddrs[member.Name] = member.Addr
+		}
+	}
+
+	var targets []health.Target
+	for _, svc := range topology.Services {
+		nodeAddr, ok := nodeAddrs[svc.NodeName]
+		if !ok {
+			continue
+		}
+
+		check, ok := s.healthChecks[svc.Name]
+		if !ok {
+			if check, ok = s.healthChecks[DefaultHealthCheck]; !ok {
+				continue
+			}
+		}
+
+		addr, ok := checkAddress(svc.Address, nodeAddr)
+		if !ok {
+			continue
+		}
+
+		targets = append(targets, health.Target{
+			Service: svc.Name,
+			Node:    svc.NodeName,
+			Address: addr,
+			Check:   check,
+		})
+	}
+
+	return targets
+}
+
+// passiveStatuses returns the status indicated by the error rate of each
+// service, or nil if passive health is disabled
+func (s *ObserverService) passiveStatuses(topology *observerv1.Topology) map[string]health.Status {
+	if s.passiveHealth == nil || s.metrics == nil {
+		return nil
+	}
+
+	now := time.Now()
+	statuses := make(map[string]health.Status)
+	for _, name := range serviceNames(topology) {
+		statuses[name] = s.passiveResult(name, now).Status
+	}
+	return statuses
+}
+
+// passiveResult returns the health indicated by the error rate of a service
+func (s *ObserverService) passiveResult(service string, now time.Time) health.Result {
+	if s.passiveHealth == nil || s.metrics == nil {
+		return health.Result{}
+	}
+
+	w, ok := s.metrics.Summary(service, s.passiveHealth.Window, now)
+	if !ok {
+		return health.Result{}
+	}
+	return s.passiveHealth.Evaluate(w)
+}
+
+// serviceStatus combines the Serf status of a service's node with its
+// health check and request error rate. The worst signal wins.
+func (s *ObserverService) serviceStatus(member *latticeserf.Member, service, address string, now time.Time) (observerv1.ServiceStatus, string) {
+	status := mapStatus(member.Status)
+	if status != observerv1.ServiceStatus_SERVICE_STATUS_HEALTHY {
+		return status, fmt.Sprintf("node %s is %s", member.Name, member.Status)
+	}
+
+	results := []health.Result{s.passiveResult(service, now)}
+	if s.checker != nil {
+		if addr, ok := checkAddress(address, member.Addr); ok {
+			if result, ok := s.checker.Result(service, member.Name, addr); ok {
+				results = append(results, result)
+			}
+		}
+	}
+
+	worst := health.Worst(results...)
+	switch worst.Status {
+	case health.StatusDegraded:
+		return observerv1.ServiceStatus_SERVICE_STATUS_DEGRADED, worst.Reason
+	case health.StatusUnhealthy:
+		return observerv1.ServiceStatus_SERVICE_STATUS_UNHEALTHY, worst.Reason
+	default:
+		return observerv1.ServiceStatus_SERVICE_STATUS_HEALTHY, ""
+	}
+}
+
+// checkAddress returns the host:port a service is probed at
+func checkAddress(addr, nodeAddr string) (string, bool) {
+	host, port, ok := serviceEndpoint(addr, nodeAddr)
+	if !ok {
+		return "", false
+	}
+	return net.JoinHostPort(host, strconv.Itoa(port)), true
+}
@@ -0,0 +1,94 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jumppad-labs/lattice/internal/health"
+	"github.com/jumppad-labs/lattice/internal/metrics"
+	latticeserf "github.com/jumppad-labs/lattice/internal/serf"
+	observerv1 "github.com/jumppad-labs/lattice/pkg/api/observer/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestObserverService_ServiceHealth(t *testing.T) {
+	// users fails its health check, orders fails requests
+	users := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer users.Close()
+
+	mesh, err := latticeserf.NewMesh(latticeserf.MeshConfig{
+		NodeName: "polymorph-1",
+		BindAddr: "127.0.0.1",
+		BindPort: 0,
+		Tags: map[string]string{
+			"services": fmt.Sprintf(`[
+				{"name":"users","type":"http","address":%q},
+				{"name":"orders","type":"http","address":"127.0.0.1:1"},
+				{"name":"billing","type":"http","address":"127.0.0.1:1"}
+			]`, strings.TrimPrefix(users.URL, "http://")),
+		},
+	})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	require.NoError(t, mesh.Start(ctx))
+	defer mesh.Stop()
+
+	svc := NewObserverService(mesh)
+
+	recorder, err := metrics.NewRecorder(metrics.Options{Windows: []time.Duration{time.Minute}})
+	require.NoError(t, err)
+	svc.SetMetrics(recorder, time.Minute)
+	svc.SetPassiveHealth(health.Passive{MinRequests: 4})
+	svc.SetHealthChecks(map[string]health.Check{
+		"users": {Type: health.TypeHTTP, Path: "/healthz", Interval: 10 * time.Millisecond, UnhealthyThreshold: 2},
+	})
+
+	now := time.Now()
+	recorder.Record("orders", []metrics.Sample{
+		{Method: "GET", Path: "/orders", Status: 200, Time: now},
+		{Method: "GET", Path: "/orders", Status: 200, Time: now},
+		{Method: "GET", Path: "/orders", Status: 200, Time: now},
+		{Method: "GET", Path: "/orders", Status: 503, Time: now},
+	}, now)
+
+	go svc.RunHealthChecks(ctx)
+
+	status := func(name string) *observerv1.Service {
+		return findService(svc.buildTopology(), name)
+	}
+
+	require.Eventually(t, func() bool {
+		return status("users").Status == observerv1.ServiceStatus_SERVICE_STATUS_UNHEALTHY
+	}, 2*time.Second, 10*time.Millisecond)
+	require.Contains(t, status("users").StatusReason, "status 503")
+
+	require.Equal(t, observerv1.ServiceStatus_SERVICE_STATUS_DEGRADED, status("orders").Status)
+	require.Contains(t, status("orders").StatusReason, "25.0% of 4 requests failed")
+
+	// Neither check nor requests
+	require.Equal(t, observerv1.ServiceStatus_SERVICE_STATUS_HEALTHY, status("billing").Status)
+	require.Empty(t, status("billing").StatusReason)
+
+	// Only healthy services are in DNS
+	instances, ok := svc.HealthyInstances("billing")
+	require.True(t, ok)
+	require.Len(t, instances, 1)
+
+	instances, ok = svc.HealthyInstances("orders")
+	require.True(t, ok)
+	require.Empty(t, instances)
+
+	instances, ok = svc.HealthyInstances("users")
+	require.True(t, ok)
+	require.Empty(t, instances)
+}
@@ -0,0 +1,117 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"connectrpc.com/connect"
+	"github.com/jumppad-labs/lattice/internal/history"
+	observerv1 "github.com/jumppad-labs/lattice/pkg/api/observer/v1"
+)
+
+// SetHistory records every published topology revision in store and enables
+// GetTopologyAt and ListTopologyChanges. Revisions continue from the last
+// recorded one, and the first revision after a restart holds the changes
+// made while Lattice was down.
+func (s *ObserverService) SetHistory(store *history.Store) error {
+	last, err := store.Last()
+	if err != nil {
+		return fmt.Errorf("failed to read topology history: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.history = store
+	if last != nil && last.Revision > s.revision {
+		s.current = last.Topology
+		s.revision = last.Revision
+	}
+	return nil
+}
+
+// recordLocked appends a published revision to the history, if enabled.
+// s.mu must be held for writing.
+func (s *ObserverService) recordLocked(delta *observerv1.TopologyDelta) {
+	if s.history == nil {
+		return
+	}
+
+	if err := s.history.Append(s.revision, s.current, delta); err != nil {
+		log.Printf("Failed to record topology revision %d: %v", s.revision, err)
+	}
+}
+
+// errHistoryDisabled is returned by queries that need topology history
+func errHistoryDisabled() error {
+	return connect.NewError(connect.CodeFailedPrecondition,
+		errors.New("topology history is not enabled, add a topology_history block to the server config"))
+}
+
+// GetTopologyAt returns the topology as it was at a point in time
+func (s *ObserverService) GetTopologyAt(
+	ctx context.Context,
+	req *connect.Request[observerv1.GetTopologyAtRequest],
+) (*connect.Response[observerv1.GetTopologyAtResponse], error) {
+	if s.history == nil {
+		return nil, errHistoryDisabled()
+	}
+
+	rev, err := s.history.At(req.Msg.Timestamp)
+	if errors.Is(err, history.ErrNotFound) {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	} else if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&observerv1.GetTopologyAtResponse{
+		Topology: rev.Topology,
+		Revision: rev.Revision,
+	}), nil
+}
+
+// ListTopologyChanges lists recorded topology revisions in a time range
+func (s *ObserverService) ListTopologyChanges(
+	ctx context.Context,
+	req *connect.Request[observerv1.ListTopologyChangesRequest],
+) (*connect.Response[observerv1.ListTopologyChangesResponse], error) {
+	if s.history == nil {
+		return nil, errHistoryDisabled()
+	}
+
+	if req.Msg.EndTime != 0 && req.Msg.StartTime >= req.Msg.EndTime {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			errors.New("start_time must be before end_time"))
+	}
+
+	limit := int(req.Msg.Limit)
+	if limit <= 0 {
+		limit = defaultSearchLimit
+	}
+	limit = min(limit, maxSearchLimit)
+
+	revisions, next, err := s.history.Changes(history.Query{
+		Start:  req.Msg.StartTime,
+		End:    req.Msg.EndTime,
+		Cursor: req.Msg.Cursor,
+		Limit:  limit,
+	})
+	if errors.Is(err, history.ErrInvalidCursor) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	} else if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	resp := &observerv1.ListTopologyChangesResponse{NextCursor: next}
+	for _, rev := range revisions {
+		resp.Changes = append(resp.Changes, &observerv1.TopologyChange{
+			Revision:  rev.Revision,
+			Timestamp: rev.Timestamp,
+			Delta:     rev.Delta,
+		})
+	}
+
+	return connect.NewResponse(resp), nil
+}
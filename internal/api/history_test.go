@@ -0,0 +1,94 @@
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jumppad-labs/lattice/internal/history"
+	latticeserf "github.com/jumppad-labs/lattice/internal/serf"
+	observerv1 "github.com/jumppad-labs/lattice/pkg/api/observer/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestObserverService_TopologyHistory(t *testing.T) {
+	mesh, err := latticeserf.NewMesh(latticeserf.MeshConfig{
+		NodeName: "test-lattice",
+	})
+	require.NoError(t, err)
+	svc := NewObserverService(mesh)
+
+	_, err = svc.GetTopologyAt(context.Background(), connect.NewRequest(&observerv1.GetTopologyAtRequest{}))
+	require.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+
+	dir := t.TempDir()
+	store, err := history.Open(history.Options{Dir: dir, MaxBytes: 1 << 20})
+	require.NoError(t, err)
+	require.NoError(t, svc.SetHistory(store))
+
+	topology := func(ts int64, names ...string) *observerv1.Topology {
+		t := &observerv1.Topology{Timestamp: ts}
+		for _, name := range names {
+			t.Services = append(t.Services, &observerv1.Service{Name: name, NodeName: "node1"})
+		}
+		return t
+	}
+
+	svc.mu.Lock()
+	svc.publishLocked(topology(1000, "api"))
+	svc.publishLocked(topology(2000, "api", "db"))
+	svc.publishLocked(topology(2500, "api", "db")) // Unchanged, not recorded
+	svc.publishLocked(topology(3000, "db"))
+	svc.mu.Unlock()
+
+	resp, err := svc.GetTopologyAt(context.Background(), connect.NewRequest(&observerv1.GetTopologyAtRequest{
+		Timestamp: 2999,
+	}))
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), resp.Msg.Revision)
+	require.Len(t, resp.Msg.Topology.Services, 2)
+
+	_, err = svc.GetTopologyAt(context.Background(), connect.NewRequest(&observerv1.GetTopologyAtRequest{
+		Timestamp: 500,
+	}))
+	require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+
+	changes, err := svc.ListTopologyChanges(context.Background(), connect.NewRequest(&observerv1.ListTopologyChangesRequest{
+		StartTime: 1500,
+	}))
+	require.NoError(t, err)
+	require.Len(t, changes.Msg.Changes, 2)
+	require.Equal(t, uint64(2), changes.Msg.Changes[0].Revision)
+	require.Equal(t, "db", changes.Msg.Changes[0].Delta.Added[0].Name)
+	require.Equal(t, "api", changes.Msg.Changes[1].Delta.Removed[0].Name)
+
+	_, err = svc.ListTopologyChanges(context.Background(), connect.NewRequest(&observerv1.ListTopologyChangesRequest{
+		StartTime: 2000,
+		EndTime:   1000,
+	}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	// After a restart revisions continue, and the first one holds what
+	// changed while Lattice was down
+	require.NoError(t, store.Close())
+	store, err = history.Open(history.Options{Dir: dir, MaxBytes: 1 << 20})
+	require.NoError(t, err)
+	defer store.Close()
+
+	restarted := NewObserverService(mesh)
+	require.NoError(t, restarted.SetHistory(store))
+
+	restarted.mu.Lock()
+	restarted.publishLocked(topology(time.Now().UnixMilli(), "db", "cache"))
+	restarted.mu.Unlock()
+
+	changes, err = restarted.ListTopologyChanges(context.Background(), connect.NewRequest(&observerv1.ListTopologyChangesRequest{
+		StartTime: 4000,
+	}))
+	require.NoError(t, err)
+	require.Len(t, changes.Msg.Changes, 1)
+	require.Equal(t, uint64(4), changes.Msg.Changes[0].Revision)
+	require.Len(t, changes.Msg.Changes[0].Delta.Added, 1)
+	require.Equal(t, "cache", changes.Msg.Changes[0].Delta.Added[0].Name)
+}
@@ -0,0 +1,82 @@
+package api
+
+import (
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/jumppad-labs/lattice/internal/meshdns"
+	observerv1 "github.com/jumppad-labs/lattice/pkg/api/observer/v1"
+)
+
+// HealthyInstances returns the healthy instances of a service that have an
+// IP address, for DNS. ok is false if no service has the name, which is
+// matched case-insensitively.
+func (s *ObserverService) HealthyInstances(service string) ([]meshdns.Instance, bool) {
+	nodeAddrs := s.nodeAddrs()
+
+	var (
+		instances []meshdns.Instance
+		found     bool
+	)
+	for _, svc := range s.buildTopology().Services {
+		if !strings.EqualFold(svc.Name, service) {
+			continue
+		}
+		found = true
+
+		if svc.Status != observerv1.ServiceStatus_SERVICE_STATUS_HEALTHY {
+			continue
+		}
+
+		host, port, ok := serviceEndpoint(svc.Address, nodeAddrs[svc.NodeName])
+		if !ok {
+			continue
+		}
+		ip := net.ParseIP(host)
+		if ip == nil {
+			continue
+		}
+
+		instances = append(instances, meshdns.Instance{
+			Node: svc.NodeName,
+			IP:   ip,
+			Port: uint16(port),
+		})
+	}
+
+	return instances, found
+}
+
+// nodeAddrs returns the address of each member by node name
+func (s *ObserverService) nodeAddrs() map[string]string {
+	addrs := make(map[string]string)
+	for _, member := range s.mesh.Members() {
+		addrs[member.Name] = member.Addr
+	}
+	return addrs
+}
+
+// serviceEndpoint splits a service address into the host and port it can be
+// reached at. Services may advertise an unspecified host such as 0.0.0.0,
+// in which case they are reached at their node's address. ok is false if
+// the address has no valid port or no host can be determined.
+func serviceEndpoint(addr, nodeAddr string) (string, int, bool) {
+	host, portStr, err := net.SplitHostPort(addr)
+	if err != nil {
+		return "", 0, false
+	}
+	port, err := strconv.Atoi(portStr)
+	if err != nil || port < 1 || port > 65535 {
+		return "", 0, false
+	}
+
+	if host == "" || net.ParseIP(host).IsUnspecified() {
+		if nodeAddr == "" {
+			return "", 0, false
+		}
+		host = nodeAddr
+	}
+
+	return host, port, true
+}
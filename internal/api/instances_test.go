@@ -0,0 +1,68 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	latticeserf "github.com/jumppad-labs/lattice/internal/serf"
+	"github.com/stretchr/testify/require"
+)
+
+func TestObserverService_HealthyInstances(t *testing.T) {
+	mesh, err := latticeserf.NewMesh(latticeserf.MeshConfig{
+		NodeName: "polymorph-1",
+		BindAddr: "127.0.0.1",
+		BindPort: 0,
+		Tags: map[string]string{
+			"services": `[
+				{"name":"users","type":"http","address":"0.0.0.0:8080"},
+				{"name":"users","type":"http","address":"10.1.2.3:8081"},
+				{"name":"users","type":"http","address":"users.internal:8082"},
+				{"name":"orders","type":"http","address":"10.1.2.3"}
+			]`,
+		},
+	})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	require.NoError(t, mesh.Start(ctx))
+	defer mesh.Stop()
+
+	svc := NewObserverService(mesh)
+
+	// Unspecified hosts resolve to the node address, hostnames are skipped
+	instances, ok := svc.HealthyInstances("USERS")
+	require.True(t, ok)
+	require.Len(t, instances, 2)
+	require.Equal(t, "127.0.0.1", instances[0].IP.String())
+	require.Equal(t, uint16(8080), instances[0].Port)
+	require.Equal(t, "10.1.2.3", instances[1].IP.String())
+	require.Equal(t, "polymorph-1", instances[1].Node)
+
+	// Known, but without a usable address
+	instances, ok = svc.HealthyInstances("orders")
+	require.True(t, ok)
+	require.Empty(t, instances)
+
+	_, ok = svc.HealthyInstances("missing")
+	require.False(t, ok)
+}
+
+func TestServiceEndpoint(t *testing.T) {
+	host, port, ok := serviceEndpoint("10.0.0.1:8080", "10.0.0.9")
+	require.True(t, ok)
+	require.Equal(t, "10.0.0.1", host)
+	require.Equal(t, 8080, port)
+
+	host, _, ok = serviceEndpoint(":8080", "10.0.0.9")
+	require.True(t, ok)
+	require.Equal(t, "10.0.0.9", host)
+
+	_, _, ok = serviceEndpoint("10.0.0.1:0", "")
+	require.False(t, ok)
+
+	_, _, ok = serviceEndpoint("10.0.0.1", "")
+	require.False(t, ok)
+}
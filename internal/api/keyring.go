@@ -0,0 +1,125 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	latticeserf "github.com/jumppad-labs/lattice/internal/serf"
+	observerv1 "github.com/jumppad-labs/lattice/pkg/api/observer/v1"
+	"github.com/jumppad-labs/lattice/pkg/api/observer/v1/observerapiconnect"
+)
+
+// KeyringService implements the Keyring API
+type KeyringService struct {
+	mesh *latticeserf.Mesh
+}
+
+// NewKeyringService creates a new KeyringService
+func NewKeyringService(mesh *latticeserf.Mesh) *KeyringService {
+	return &KeyringService{mesh: mesh}
+}
+
+// Verify interface implementation
+var _ observerapiconnect.KeyringServiceHandler = (*KeyringService)(nil)
+
+// ListKeys lists the keys installed on members of the mesh
+func (s *KeyringService) ListKeys(
+	ctx context.Context,
+	req *connect.Request[observerv1.ListKeysRequest],
+) (*connect.Response[observerv1.ListKeysResponse], error) {
+	result, err := keyringResult(s.mesh.ListKeys())
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&observerv1.ListKeysResponse{Result: result}), nil
+}
+
+// InstallKey installs a new key on all members of the mesh
+func (s *KeyringService) InstallKey(
+	ctx context.Context,
+	req *connect.Request[observerv1.InstallKeyRequest],
+) (*connect.Response[observerv1.InstallKeyResponse], error) {
+	if err := validateKey(req.Msg.Key); err != nil {
+		return nil, err
+	}
+
+	result, err := keyringResult(s.mesh.InstallKey(req.Msg.Key))
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&observerv1.InstallKeyResponse{Result: result}), nil
+}
+
+// UseKey changes the primary key used to encrypt gossip messages
+func (s *KeyringService) UseKey(
+	ctx context.Context,
+	req *connect.Request[observerv1.UseKeyRequest],
+) (*connect.Response[observerv1.UseKeyResponse], error) {
+	if err := validateKey(req.Msg.Key); err != nil {
+		return nil, err
+	}
+
+	result, err := keyringResult(s.mesh.UseKey(req.Msg.Key))
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&observerv1.UseKeyResponse{Result: result}), nil
+}
+
+// RemoveKey removes a key from all members of the mesh
+func (s *KeyringService) RemoveKey(
+	ctx context.Context,
+	req *connect.Request[observerv1.RemoveKeyRequest],
+) (*connect.Response[observerv1.RemoveKeyResponse], error) {
+	if err := validateKey(req.Msg.Key); err != nil {
+		return nil, err
+	}
+
+	result, err := keyringResult(s.mesh.RemoveKey(req.Msg.Key))
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&observerv1.RemoveKeyResponse{Result: result}), nil
+}
+
+// validateKey checks that a key is well-formed before it is sent to the mesh
+func validateKey(key string) error {
+	if _, err := latticeserf.DecodeKey(key); err != nil {
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	return nil
+}
+
+// keyringResult converts a mesh key response. Errors reported by individual
+// nodes are returned in the result; an error is only returned if the
+// operation could not be performed at all (e.g. encryption is disabled).
+func keyringResult(resp *latticeserf.KeyResponse, err error) (*observerv1.KeyringResult, error) {
+	if resp == nil || (err != nil && resp.NumErr == 0) {
+		return nil, connect.NewError(connect.CodeFailedPrecondition,
+			fmt.Errorf("keyring operation failed: %w", err))
+	}
+
+	result := &observerv1.KeyringResult{
+		NumNodes:     int32(resp.NumNodes),
+		NumResponses: int32(resp.NumResp),
+		NumErrors:    int32(resp.NumErr),
+		Messages:     resp.Messages,
+		Keys:         make(map[string]int32, len(resp.Keys)),
+		PrimaryKeys:  make(map[string]int32, len(resp.PrimaryKeys)),
+	}
+
+	// Keys are reported by fingerprint so the API never returns key material
+	for key, count := range resp.Keys {
+		result.Keys[latticeserf.KeyFingerprint(key)] = int32(count)
+	}
+	for key, count := range resp.PrimaryKeys {
+		result.PrimaryKeys[latticeserf.KeyFingerprint(key)] = int32(count)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,25 @@
+package api
+
+import (
+	"testing"
+
+	latticeserf "github.com/jumppad-labs/lattice/internal/serf"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyringResultHidesKeys(t *testing.T) {
+	key, err := latticeserf.GenerateKey()
+	require.NoError(t, err)
+
+	result, err := keyringResult(&latticeserf.KeyResponse{
+		NumNodes:    2,
+		NumResp:     2,
+		Keys:        map[string]int{key: 2},
+		PrimaryKeys: map[string]int{key: 1},
+	}, nil)
+	require.NoError(t, err)
+
+	fingerprint := latticeserf.KeyFingerprint(key)
+	require.Equal(t, map[string]int32{fingerprint: 2}, result.Keys)
+	require.Equal(t, map[string]int32{fingerprint: 1}, result.PrimaryKeys)
+}
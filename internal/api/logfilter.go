@@ -0,0 +1,76 @@
+package api
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	observerv1 "github.com/jumppad-labs/lattice/pkg/api/observer/v1"
+)
+
+// logLevels orders request log levels from least to most severe
+var logLevels = map[string]int{
+	"debug": 0,
+	"info":  1,
+	"warn":  2,
+	"error": 3,
+}
+
+// validateLogFilter checks a filter for contradictory or unknown values
+func validateLogFilter(filter *observerv1.RequestLogFilter) error {
+	if filter == nil {
+		return nil
+	}
+
+	if filter.MinStatus < 0 || filter.MaxStatus < 0 {
+		return fmt.Errorf("status bounds must not be negative")
+	}
+	if filter.MaxStatus != 0 && filter.MinStatus > filter.MaxStatus {
+		return fmt.Errorf("min_status %d is greater than max_status %d", filter.MinStatus, filter.MaxStatus)
+	}
+	if filter.MinDurationMs < 0 {
+		return fmt.Errorf("min_duration_ms must not be negative")
+	}
+	if filter.StartTime < 0 {
+		return fmt.Errorf("start_time must not be negative")
+	}
+	if _, ok := logLevels[strings.ToLower(filter.MinLevel)]; filter.MinLevel != "" && !ok {
+		return fmt.Errorf("unknown level %q (expected debug, info, warn or error)", filter.MinLevel)
+	}
+
+	return nil
+}
+
+// matchRequestLog reports whether a log matches a filter. A nil filter
+// matches everything.
+func matchRequestLog(filter *observerv1.RequestLogFilter, entry *observerv1.RequestLog) bool {
+	if filter == nil {
+		return true
+	}
+
+	if len(filter.Methods) > 0 && !slices.ContainsFunc(filter.Methods, func(method string) bool {
+		return strings.EqualFold(method, entry.Method)
+	}) {
+		return false
+	}
+	if !strings.HasPrefix(entry.Path, filter.PathPrefix) {
+		return false
+	}
+	if filter.MinStatus != 0 && entry.Status < filter.MinStatus {
+		return false
+	}
+	if filter.MaxStatus != 0 && entry.Status > filter.MaxStatus {
+		return false
+	}
+	if entry.DurationMs < filter.MinDurationMs {
+		return false
+	}
+	if entry.Timestamp < filter.StartTime {
+		return false
+	}
+	if filter.MinLevel != "" && logLevels[strings.ToLower(entry.Level)] < logLevels[strings.ToLower(filter.MinLevel)] {
+		return false
+	}
+
+	return true
+}
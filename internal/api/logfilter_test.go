@@ -0,0 +1,68 @@
+package api
+
+import (
+	"testing"
+
+	observerv1 "github.com/jumppad-labs/lattice/pkg/api/observer/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatchRequestLog(t *testing.T) {
+	entry := &observerv1.RequestLog{
+		Timestamp:  1700000000000,
+		Method:     "POST",
+		Path:       "/api/orders/42",
+		Status:     503,
+		DurationMs: 120,
+		Level:      "error",
+	}
+
+	tests := []struct {
+		name   string
+		filter *observerv1.RequestLogFilter
+		match  bool
+	}{
+		{"nil filter", nil, true},
+		{"empty filter", &observerv1.RequestLogFilter{}, true},
+		{"method", &observerv1.RequestLogFilter{Methods: []string{"get", "post"}}, true},
+		{"other method", &observerv1.RequestLogFilter{Methods: []string{"GET"}}, false},
+		{"path prefix", &observerv1.RequestLogFilter{PathPrefix: "/api/orders"}, true},
+		{"other path", &observerv1.RequestLogFilter{PathPrefix: "/api/users"}, false},
+		{"status range", &observerv1.RequestLogFilter{MinStatus: 500, MaxStatus: 599}, true},
+		{"below min status", &observerv1.RequestLogFilter{MinStatus: 504}, false},
+		{"above max status", &observerv1.RequestLogFilter{MaxStatus: 499}, false},
+		{"min duration", &observerv1.RequestLogFilter{MinDurationMs: 100}, true},
+		{"too fast", &observerv1.RequestLogFilter{MinDurationMs: 500}, false},
+		{"min level", &observerv1.RequestLogFilter{MinLevel: "warn"}, true},
+		{"start time", &observerv1.RequestLogFilter{StartTime: 1700000000000}, true},
+		{"before start time", &observerv1.RequestLogFilter{StartTime: 1700000000001}, false},
+		{"all fields", &observerv1.RequestLogFilter{
+			Methods:       []string{"POST"},
+			PathPrefix:    "/api",
+			MinStatus:     500,
+			MinDurationMs: 50,
+			MinLevel:      "ERROR",
+		}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.match, matchRequestLog(tt.filter, entry))
+		})
+	}
+
+	debug := &observerv1.RequestLog{Level: "debug"}
+	require.False(t, matchRequestLog(&observerv1.RequestLogFilter{MinLevel: "info"}, debug))
+}
+
+func TestValidateLogFilter(t *testing.T) {
+	require.NoError(t, validateLogFilter(nil))
+	require.NoError(t, validateLogFilter(&observerv1.RequestLogFilter{MinStatus: 400, MaxStatus: 499, MinLevel: "warn"}))
+	require.NoError(t, validateLogFilter(&observerv1.RequestLogFilter{MinStatus: 500}))
+
+	require.Error(t, validateLogFilter(&observerv1.RequestLogFilter{MinStatus: 500, MaxStatus: 400}))
+	require.Error(t, validateLogFilter(&observerv1.RequestLogFilter{MinStatus: -1}))
+	require.Error(t, validateLogFilter(&observerv1.RequestLogFilter{MinDurationMs: -5}))
+	require.Error(t, validateLogFilter(&observerv1.RequestLogFilter{MinLevel: "fatal"}))
+	require.Error(t, validateLogFilter(&observerv1.RequestLogFilter{StartTime: -1}))
+}
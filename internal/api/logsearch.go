@@ -0,0 +1,91 @@
+package api
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	"github.com/jumppad-labs/lattice/internal/logstore"
+	observerv1 "github.com/jumppad-labs/lattice/pkg/api/observer/v1"
+)
+
+const (
+	// defaultSearchLimit is the page size when the request does not set one
+	defaultSearchLimit = 100
+
+	// maxSearchLimit bounds the page size
+	maxSearchLimit = 1000
+)
+
+// SetLogStore enables SearchRequestLogs backed by store. Logs are only
+// added to the store while CollectLogs is running.
+func (s *ObserverService) SetLogStore(store *logstore.Store) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.logStore = store
+}
+
+// SearchRequestLogs searches request logs retained by Lattice
+func (s *ObserverService) SearchRequestLogs(
+	ctx context.Context,
+	req *connect.Request[observerv1.SearchRequestLogsRequest],
+) (*connect.Response[observerv1.SearchRequestLogsResponse], error) {
+	if s.logStore == nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition,
+			errors.New("request log retention is not enabled, add a log_store block to the server config"))
+	}
+
+	if err := validateLogFilter(req.Msg.Filter); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if req.Msg.EndTime != 0 && req.Msg.StartTime >= req.Msg.EndTime {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			errors.New("start_time must be before end_time"))
+	}
+
+	limit := int(req.Msg.Limit)
+	if limit <= 0 {
+		limit = defaultSearchLimit
+	}
+	limit = min(limit, maxSearchLimit)
+
+	entries, next, err := s.logStore.Search(logstore.Query{
+		Services: req.Msg.ServiceNames,
+		Start:    req.Msg.StartTime,
+		End:      req.Msg.EndTime,
+		Match: func(entry *logstore.Entry) bool {
+			return matchRequestLog(req.Msg.Filter, entryToRequestLog(entry))
+		},
+		Cursor: req.Msg.Cursor,
+		Limit:  limit,
+	})
+	if errors.Is(err, logstore.ErrInvalidCursor) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	} else if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	resp := &observerv1.SearchRequestLogsResponse{NextCursor: next}
+	for _, entry := range entries {
+		resp.Logs = append(resp.Logs, &observerv1.ServiceRequestLog{
+			ServiceName: entry.Service,
+			Log:         entryToRequestLog(entry),
+		})
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// entryToRequestLog converts a stored entry to its API representation
+func entryToRequestLog(entry *logstore.Entry) *observerv1.RequestLog {
+	return &observerv1.RequestLog{
+		Sequence:   entry.Sequence,
+		Timestamp:  entry.Timestamp,
+		Method:     entry.Method,
+		Path:       entry.Path,
+		Status:     entry.Status,
+		DurationMs: entry.DurationMs,
+		Level:      entry.Level,
+	}
+}
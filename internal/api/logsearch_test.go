@@ -0,0 +1,68 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/jumppad-labs/lattice/internal/logstore"
+	latticeserf "github.com/jumppad-labs/lattice/internal/serf"
+	observerv1 "github.com/jumppad-labs/lattice/pkg/api/observer/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestObserverService_SearchRequestLogs(t *testing.T) {
+	mesh, err := latticeserf.NewMesh(latticeserf.MeshConfig{
+		NodeName: "test-lattice",
+	})
+	require.NoError(t, err)
+	svc := NewObserverService(mesh)
+
+	_, err = svc.SearchRequestLogs(context.Background(), connect.NewRequest(&observerv1.SearchRequestLogsRequest{}))
+	require.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+
+	store, err := logstore.Open(logstore.Options{Dir: t.TempDir(), MaxBytes: 1 << 20})
+	require.NoError(t, err)
+	defer store.Close()
+	svc.SetLogStore(store)
+
+	require.NoError(t, store.Append([]logstore.Entry{
+		{Service: "api", Sequence: 1, Timestamp: 1000, Method: "GET", Path: "/users", Status: 200},
+		{Service: "api", Sequence: 2, Timestamp: 2000, Method: "POST", Path: "/users", Status: 500},
+		{Service: "orders", Sequence: 1, Timestamp: 2500, Method: "GET", Path: "/orders", Status: 503},
+		{Service: "orders", Sequence: 2, Timestamp: 3000, Method: "GET", Path: "/orders", Status: 200},
+	}))
+
+	resp, err := svc.SearchRequestLogs(context.Background(), connect.NewRequest(&observerv1.SearchRequestLogsRequest{
+		StartTime: 1500,
+		Filter:    &observerv1.RequestLogFilter{MinStatus: 500},
+		Limit:     1,
+	}))
+	require.NoError(t, err)
+	require.Len(t, resp.Msg.Logs, 1)
+	require.Equal(t, "api", resp.Msg.Logs[0].ServiceName)
+	require.Equal(t, "POST", resp.Msg.Logs[0].Log.Method)
+	require.NotEmpty(t, resp.Msg.NextCursor)
+
+	resp, err = svc.SearchRequestLogs(context.Background(), connect.NewRequest(&observerv1.SearchRequestLogsRequest{
+		StartTime: 1500,
+		Filter:    &observerv1.RequestLogFilter{MinStatus: 500},
+		Limit:     1,
+		Cursor:    resp.Msg.NextCursor,
+	}))
+	require.NoError(t, err)
+	require.Len(t, resp.Msg.Logs, 1)
+	require.Equal(t, "orders", resp.Msg.Logs[0].ServiceName)
+	require.Empty(t, resp.Msg.NextCursor)
+
+	_, err = svc.SearchRequestLogs(context.Background(), connect.NewRequest(&observerv1.SearchRequestLogsRequest{
+		Cursor: "not-a-cursor",
+	}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	_, err = svc.SearchRequestLogs(context.Background(), connect.NewRequest(&observerv1.SearchRequestLogsRequest{
+		StartTime: 2000,
+		EndTime:   1000,
+	}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
@@ -0,0 +1,261 @@
+package api
+
+import (
+	"context"
+	"log"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	observerv1 "github.com/jumppad-labs/lattice/pkg/api/observer/v1"
+)
+
+const (
+	// logPollInterval is how often the upstream subscription for a watched
+	// service polls for new logs
+	logPollInterval = time.Second
+
+	// logPollMaxBackoff bounds the delay between polls while upstream fails
+	logPollMaxBackoff = 10 * time.Second
+
+	// logBufferSize is how many recent logs are kept per watched service.
+	// Watchers can resume from any sequence still in the buffer.
+	logBufferSize = 1000
+
+	// logBatchSize bounds the number of logs in one upstream fetch and in
+	// one streamed message
+	logBatchSize = 500
+)
+
+// fetchLogsFunc fetches request logs for a service after a sequence
+type fetchLogsFunc func(ctx context.Context, service string, afterSequence uint64, limit int32) (*observerv1.GetRequestLogsResponse, error)
+
+// logHub shares one upstream subscription per service among all
+// WatchRequestLogs streams for that service
+type logHub struct {
+	fetch    fetchLogsFunc
+	interval time.Duration
+
+	mu   sync.Mutex
+	subs map[string]*logSubscription
+}
+
+// newLogHub creates a logHub that polls upstream with fetch
+func newLogHub(fetch fetchLogsFunc) *logHub {
+	return &logHub{
+		fetch:    fetch,
+		interval: logPollInterval,
+		subs:     make(map[string]*logSubscription),
+	}
+}
+
+// logSubscription polls a service for new logs and buffers them for its
+// watchers. Watchers read from the buffer at their own pace, so a slow
+// watcher never blocks polling or other watchers.
+type logSubscription struct {
+	service string
+	cancel  context.CancelFunc
+
+	mu       sync.Mutex
+	watchers map[*logWatcher]struct{}
+	logs     []*observerv1.RequestLog // Recent logs in sequence order
+	latest   uint64
+	epoch    uint64 // Incremented when upstream sequences restart
+	ready    bool   // Set once the backlog has been fetched
+}
+
+// logWatcher is a WatchRequestLogs stream reading from a subscription
+type logWatcher struct {
+	// notify is signalled when new logs are buffered. It holds at most one
+	// pending signal, so bursts coalesce while the watcher is busy sending.
+	notify chan struct{}
+}
+
+// subscribe registers a watcher for a service, starting the upstream
+// subscription if this is the first watcher
+func (h *logHub) subscribe(service string) (*logSubscription, *logWatcher) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	sub, ok := h.subs[service]
+	if !ok {
+		ctx, cancel := context.WithCancel(context.Background())
+		sub = &logSubscription{
+			service:  service,
+			cancel:   cancel,
+			watchers: make(map[*logWatcher]struct{}),
+		}
+		h.subs[service] = sub
+		go sub.run(ctx, h.fetch, h.interval)
+	}
+
+	w := &logWatcher{notify: make(chan struct{}, 1)}
+	sub.mu.Lock()
+	sub.watchers[w] = struct{}{}
+	sub.mu.Unlock()
+
+	return sub, w
+}
+
+// unsubscribe removes a watcher, stopping the upstream subscription when
+// no watchers remain
+func (h *logHub) unsubscribe(sub *logSubscription, w *logWatcher) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	sub.mu.Lock()
+	delete(sub.watchers, w)
+	remaining := len(sub.watchers)
+	sub.mu.Unlock()
+
+	if remaining == 0 {
+		sub.cancel()
+		delete(h.subs, sub.service)
+	}
+}
+
+// watchers returns the number of watchers across all subscriptions
+func (h *logHub) watchers() int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	var n int
+	for _, sub := range h.subs {
+		sub.mu.Lock()
+		n += len(sub.watchers)
+		sub.mu.Unlock()
+	}
+	return n
+}
+
+// run polls upstream until the subscription is cancelled
+func (sub *logSubscription) run(ctx context.Context, fetch fetchLogsFunc, interval time.Duration) {
+	delay := interval
+	for {
+		sub.mu.Lock()
+		after := sub.latest
+		sub.mu.Unlock()
+
+		resp, err := fetch(ctx, sub.service, after, logBatchSize)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			log.Printf("Failed to fetch request logs for %s: %v", sub.service, err)
+			delay = min(delay*2, logPollMaxBackoff)
+		} else {
+			delay = interval
+			if sub.append(resp) && len(resp.Logs) == logBatchSize {
+				// More logs are waiting upstream
+				continue
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+	}
+}
+
+// append buffers fetched logs and wakes watchers. It returns false if the
+// upstream sequence restarted and the logs were discarded.
+func (sub *logSubscription) append(resp *observerv1.GetRequestLogsResponse) bool {
+	sub.mu.Lock()
+	defer sub.mu.Unlock()
+
+	if resp.LatestSequence < sub.latest {
+		// The service restarted, fetch again from the beginning
+		sub.logs = nil
+		sub.latest = 0
+		sub.epoch++
+		return false
+	}
+
+	for _, entry := range resp.Logs {
+		if entry.Sequence > sub.latest {
+			sub.logs = append(sub.logs, entry)
+			sub.latest = entry.Sequence
+		}
+	}
+	if len(sub.logs) > logBufferSize {
+		sub.logs = slices.Clone(sub.logs[len(sub.logs)-logBufferSize:])
+	}
+
+	// Wait until the backlog has been fetched, so a watcher resuming from a
+	// recent sequence isn't mistaken for one from before a restart
+	if len(resp.Logs) < logBatchSize {
+		sub.ready = true
+	}
+	if !sub.ready {
+		return true
+	}
+
+	for w := range sub.watchers {
+		select {
+		case w.notify <- struct{}{}:
+		default:
+		}
+	}
+
+	return true
+}
+
+// logCursor tracks what a watcher has been sent
+type logCursor struct {
+	sequence uint64
+	epoch    uint64
+	started  bool
+}
+
+// next returns the next message for a watcher and advances its cursor, or
+// nil if there is nothing new
+func (sub *logSubscription) next(cursor *logCursor) *observerv1.WatchRequestLogsResponse {
+	sub.mu.Lock()
+	defer sub.mu.Unlock()
+
+	if !sub.ready {
+		return nil
+	}
+
+	resp := &observerv1.WatchRequestLogsResponse{}
+
+	if !cursor.started {
+		cursor.started = true
+		cursor.epoch = sub.epoch
+		if cursor.sequence > sub.latest {
+			// Resuming from a sequence the service no longer has
+			resp.Restarted = true
+			cursor.sequence = 0
+		}
+	} else if cursor.epoch != sub.epoch {
+		resp.Restarted = true
+		cursor.epoch = sub.epoch
+		cursor.sequence = 0
+	}
+
+	start := sort.Search(len(sub.logs), func(i int) bool {
+		return sub.logs[i].Sequence > cursor.sequence
+	})
+
+	// Only a resumed or started watcher can be behind the buffer
+	if start == 0 && cursor.sequence > 0 && len(sub.logs) > 0 && sub.logs[0].Sequence > cursor.sequence+1 {
+		resp.Truncated = true
+	}
+
+	end := min(start+logBatchSize, len(sub.logs))
+	resp.Logs = slices.Clone(sub.logs[start:end])
+	resp.LatestSequence = sub.latest
+
+	if len(resp.Logs) == 0 && !resp.Restarted && !resp.Truncated {
+		return nil
+	}
+
+	if len(resp.Logs) > 0 {
+		cursor.sequence = resp.Logs[len(resp.Logs)-1].Sequence
+	}
+
+	return resp
+}
@@ -0,0 +1,205 @@
+package api
+
+import (
+	"context"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	observerv1 "github.com/jumppad-labs/lattice/pkg/api/observer/v1"
+	"github.com/jumppad-labs/lattice/pkg/api/observer/v1/observerapiconnect"
+	"github.com/stretchr/testify/require"
+)
+
+// testLogs returns request logs with the given sequences
+func testLogs(sequences ...uint64) []*observerv1.RequestLog {
+	logs := make([]*observerv1.RequestLog, 0, len(sequences))
+	for _, seq := range sequences {
+		logs = append(logs, &observerv1.RequestLog{Sequence: seq, Method: "GET", Path: "/", Status: 200})
+	}
+	return logs
+}
+
+// sequences returns the sequence numbers of logs
+func sequences(logs []*observerv1.RequestLog) []uint64 {
+	seqs := make([]uint64, 0, len(logs))
+	for _, entry := range logs {
+		seqs = append(seqs, entry.Sequence)
+	}
+	return seqs
+}
+
+func TestLogSubscriptionNext(t *testing.T) {
+	sub := &logSubscription{watchers: make(map[*logWatcher]struct{})}
+
+	// Nothing is sent before the first fetch
+	cursor := &logCursor{}
+	require.Nil(t, sub.next(cursor))
+
+	sub.append(&observerv1.GetRequestLogsResponse{Logs: testLogs(1, 2, 3), LatestSequence: 3})
+	resp := sub.next(cursor)
+	require.Equal(t, []uint64{1, 2, 3}, sequences(resp.Logs))
+	require.Equal(t, uint64(3), resp.LatestSequence)
+	require.Nil(t, sub.next(cursor))
+
+	// Duplicates from overlapping fetches are ignored
+	sub.append(&observerv1.GetRequestLogsResponse{Logs: testLogs(3, 4), LatestSequence: 4})
+	require.Equal(t, []uint64{4}, sequences(sub.next(cursor).Logs))
+
+	// Resuming within the buffer
+	resumed := &logCursor{sequence: 2}
+	require.Equal(t, []uint64{3, 4}, sequences(sub.next(resumed).Logs))
+
+	// The service restarted and sequences started over
+	require.False(t, sub.append(&observerv1.GetRequestLogsResponse{LatestSequence: 1}))
+	sub.append(&observerv1.GetRequestLogsResponse{Logs: testLogs(1), LatestSequence: 1})
+	resp = sub.next(cursor)
+	require.True(t, resp.Restarted)
+	require.Equal(t, []uint64{1}, sequences(resp.Logs))
+
+	// Resuming from a sequence from before the restart
+	stale := &logCursor{sequence: 4}
+	resp = sub.next(stale)
+	require.True(t, resp.Restarted)
+	require.Equal(t, []uint64{1}, sequences(resp.Logs))
+}
+
+func TestLogSubscriptionTruncated(t *testing.T) {
+	sub := &logSubscription{watchers: make(map[*logWatcher]struct{})}
+
+	logs := make([]uint64, 0, logBufferSize+10)
+	for seq := uint64(1); seq <= logBufferSize+10; seq++ {
+		logs = append(logs, seq)
+	}
+	sub.append(&observerv1.GetRequestLogsResponse{Logs: testLogs(logs...), LatestSequence: logBufferSize + 10})
+	require.Len(t, sub.logs, logBufferSize)
+
+	// A full batch means the backlog may not be fetched yet
+	require.Nil(t, sub.next(&logCursor{}))
+	sub.append(&observerv1.GetRequestLogsResponse{LatestSequence: logBufferSize + 10})
+
+	// A watcher resuming from before the buffer learns logs were dropped
+	cursor := &logCursor{sequence: 5}
+	resp := sub.next(cursor)
+	require.True(t, resp.Truncated)
+	require.Equal(t, uint64(11), resp.Logs[0].Sequence)
+	require.Len(t, resp.Logs, logBatchSize)
+
+	// The rest follows in the next message
+	resp = sub.next(cursor)
+	require.False(t, resp.Truncated)
+	require.Len(t, resp.Logs, logBufferSize-logBatchSize)
+	require.Nil(t, sub.next(cursor))
+}
+
+func TestLogHubSharesSubscription(t *testing.T) {
+	var (
+		mu    sync.Mutex
+		calls = make(map[string]int)
+	)
+	hub := newLogHub(func(ctx context.Context, service string, afterSequence uint64, limit int32) (*observerv1.GetRequestLogsResponse, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		calls[service]++
+		seq := afterSequence + 1
+		return &observerv1.GetRequestLogsResponse{Logs: testLogs(seq), LatestSequence: seq}, nil
+	})
+	hub.interval = 10 * time.Millisecond
+
+	sub1, w1 := hub.subscribe("api")
+	sub2, w2 := hub.subscribe("api")
+	require.Same(t, sub1, sub2)
+	require.Len(t, hub.subs, 1)
+
+	// Both watchers are woken by the shared poller
+	for _, w := range []*logWatcher{w1, w2} {
+		select {
+		case <-w.notify:
+		case <-time.After(time.Second):
+			t.Fatal("timeout waiting for logs")
+		}
+	}
+
+	hub.unsubscribe(sub1, w1)
+	require.Len(t, hub.subs, 1)
+	hub.unsubscribe(sub2, w2)
+	require.Empty(t, hub.subs)
+
+	// The poller stops once the last watcher leaves
+	time.Sleep(30 * time.Millisecond)
+	mu.Lock()
+	stopped := calls["api"]
+	mu.Unlock()
+	time.Sleep(50 * time.Millisecond)
+	mu.Lock()
+	defer mu.Unlock()
+	require.Equal(t, stopped, calls["api"])
+}
+
+func TestObserverService_WatchRequestLogs(t *testing.T) {
+	a := &fakeMeta{}
+	router := newTestRouter(t, startMeta(t, a), "127.0.0.1:1")
+
+	svc := NewObserverService(router.mesh)
+	svc.router = router
+
+	_, handler := observerapiconnect.NewObserverServiceHandler(svc)
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	client := observerapiconnect.NewObserverServiceClient(server.Client(), server.URL)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	stream, err := client.WatchRequestLogs(ctx, connect.NewRequest(&observerv1.WatchRequestLogsRequest{
+		ServiceName: "target",
+	}))
+	require.NoError(t, err)
+	defer stream.Close()
+
+	require.True(t, stream.Receive(), stream.Err())
+	require.Equal(t, []uint64{7}, sequences(stream.Msg().Logs))
+	require.Equal(t, uint64(7), stream.Msg().LatestSequence)
+
+	// Logs are filtered before they are sent
+	matching, err := client.WatchRequestLogs(ctx, connect.NewRequest(&observerv1.WatchRequestLogsRequest{
+		ServiceName: "target",
+		Filter:      &observerv1.RequestLogFilter{Methods: []string{"get"}},
+	}))
+	require.NoError(t, err)
+	defer matching.Close()
+	require.True(t, matching.Receive(), matching.Err())
+	require.Equal(t, []uint64{7}, sequences(matching.Msg().Logs))
+
+	filterCtx, filterCancel := context.WithTimeout(ctx, 200*time.Millisecond)
+	defer filterCancel()
+	filtered, err := client.WatchRequestLogs(filterCtx, connect.NewRequest(&observerv1.WatchRequestLogsRequest{
+		ServiceName: "target",
+		Filter:      &observerv1.RequestLogFilter{MinStatus: 500},
+	}))
+	if err == nil {
+		// Headers are only flushed with the first message, so the call
+		// itself may time out instead
+		defer filtered.Close()
+		require.False(t, filtered.Receive())
+	}
+
+	invalid, err := client.WatchRequestLogs(ctx, connect.NewRequest(&observerv1.WatchRequestLogsRequest{
+		ServiceName: "target",
+		Filter:      &observerv1.RequestLogFilter{MinLevel: "fatal"},
+	}))
+	require.NoError(t, err)
+	require.False(t, invalid.Receive())
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(invalid.Err()))
+
+	// Unknown services are rejected
+	missing, err := client.WatchRequestLogs(ctx, connect.NewRequest(&observerv1.WatchRequestLogsRequest{
+		ServiceName: "unknown",
+	}))
+	require.NoError(t, err)
+	require.False(t, missing.Receive())
+	require.Equal(t, connect.CodeNotFound, connect.CodeOf(missing.Err()))
+}
@@ -0,0 +1,56 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"connectrpc.com/connect"
+	latticeserf "github.com/jumppad-labs/lattice/internal/serf"
+	observerv1 "github.com/jumppad-labs/lattice/pkg/api/observer/v1"
+)
+
+// ListMembers lists the nodes in the gossip mesh
+func (s *ObserverService) ListMembers(
+	ctx context.Context,
+	req *connect.Request[observerv1.ListMembersRequest],
+) (*connect.Response[observerv1.ListMembersResponse], error) {
+	members := s.mesh.Members()
+
+	if status := req.Msg.Status; status != "" {
+		if !slices.Contains(memberStatuses, status) {
+			return nil, connect.NewError(connect.CodeInvalidArgument,
+				fmt.Errorf("invalid status %q, must be one of %s", status, strings.Join(memberStatuses, ", ")))
+		}
+		members = latticeserf.FilterMembers(members, latticeserf.FilterByStatus(status))
+	}
+	for key, value := range req.Msg.Tags {
+		members = latticeserf.FilterMembers(members, latticeserf.FilterByTag(key, value))
+	}
+
+	// Services come from the topology so they are parsed the same way
+	services := make(map[string][]string)
+	for _, svc := range s.buildTopology().Services {
+		if !slices.Contains(services[svc.NodeName], svc.Name) {
+			services[svc.NodeName] = append(services[svc.NodeName], svc.Name)
+		}
+	}
+
+	resp := &observerv1.ListMembersResponse{}
+	for _, m := range members {
+		resp.Members = append(resp.Members, &observerv1.Member{
+			Name:     m.Name,
+			Address:  m.Addr,
+			Port:     uint32(m.Port),
+			Status:   m.Status,
+			Tags:     m.Tags,
+			Services: services[m.Name],
+		})
+	}
+	slices.SortFunc(resp.Members, func(a, b *observerv1.Member) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return connect.NewResponse(resp), nil
+}
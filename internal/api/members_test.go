@@ -0,0 +1,52 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	latticeserf "github.com/jumppad-labs/lattice/internal/serf"
+	observerv1 "github.com/jumppad-labs/lattice/pkg/api/observer/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestObserverService_ListMembers(t *testing.T) {
+	mesh, err := latticeserf.NewMesh(latticeserf.MeshConfig{
+		NodeName: "polymorph-1",
+		BindAddr: "127.0.0.1",
+		BindPort: 0,
+		Tags: map[string]string{
+			"zone":     "a",
+			"services": `[{"name":"users","type":"http"},{"name":"orders","type":"http"}]`,
+		},
+	})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	require.NoError(t, mesh.Start(ctx))
+	defer mesh.Stop()
+
+	svc := NewObserverService(mesh)
+
+	list := func(req *observerv1.ListMembersRequest) []*observerv1.Member {
+		resp, err := svc.ListMembers(context.Background(), connect.NewRequest(req))
+		require.NoError(t, err)
+		return resp.Msg.Members
+	}
+
+	members := list(&observerv1.ListMembersRequest{})
+	require.Len(t, members, 1)
+	require.Equal(t, "polymorph-1", members[0].Name)
+	require.Equal(t, "127.0.0.1", members[0].Address)
+	require.Equal(t, "alive", members[0].Status)
+	require.Equal(t, []string{"users", "orders"}, members[0].Services)
+
+	require.Len(t, list(&observerv1.ListMembersRequest{Status: "alive", Tags: map[string]string{"zone": "a"}}), 1)
+	require.Empty(t, list(&observerv1.ListMembersRequest{Status: "failed"}))
+	require.Empty(t, list(&observerv1.ListMembersRequest{Tags: map[string]string{"zone": "b"}}))
+
+	_, err = svc.ListMembers(context.Background(), connect.NewRequest(&observerv1.ListMembersRequest{Status: "dead"}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
@@ -0,0 +1,110 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jumppad-labs/lattice/internal/metrics"
+	observerv1 "github.com/jumppad-labs/lattice/pkg/api/observer/v1"
+)
+
+// SetMetrics enables GetServiceMetrics and the metrics summary on each
+// service in the topology, computed over summaryWindow. Samples are only
+// recorded while CollectLogs is running.
+func (s *ObserverService) SetMetrics(recorder *metrics.Recorder, summaryWindow time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.metrics = recorder
+	s.summaryWindow = summaryWindow
+}
+
+// GetServiceMetrics returns request metrics per service and route
+func (s *ObserverService) GetServiceMetrics(
+	ctx context.Context,
+	req *connect.Request[observerv1.GetServiceMetricsRequest],
+) (*connect.Response[observerv1.GetServiceMetricsResponse], error) {
+	if s.metrics == nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition,
+			errors.New("request metrics are not enabled, add a metrics block to the server config"))
+	}
+
+	names := req.Msg.ServiceNames
+	if len(names) == 0 {
+		names = s.metrics.Services()
+	}
+
+	now := time.Now()
+	var topology *observerv1.Topology
+
+	resp := &observerv1.GetServiceMetricsResponse{}
+	for _, name := range names {
+		m, ok := s.metrics.Service(name, req.Msg.IncludeRoutes, now)
+		if !ok {
+			// A service without requests yet has zero metrics, but one that
+			// was never seen is most likely a typo
+			if topology == nil {
+				topology = s.router.topology()
+			}
+			if findService(topology, name) == nil {
+				return nil, connect.NewError(connect.CodeNotFound,
+					fmt.Errorf("service %q not found", name))
+			}
+		}
+
+		svc := &observerv1.ServiceMetrics{
+			ServiceName: name,
+			Windows:     requestMetricsToProto(m.Windows...),
+		}
+		for _, route := range m.Routes {
+			svc.Routes = append(svc.Routes, &observerv1.RouteMetrics{
+				Method:  route.Method,
+				Path:    route.Path,
+				Windows: requestMetricsToProto(route.Windows...),
+			})
+		}
+		resp.Services = append(resp.Services, svc)
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// metricsSummary returns the metrics of a service over the summary window,
+// or nil if metrics are disabled
+func (s *ObserverService) metricsSummary(service string, now time.Time) *observerv1.RequestMetrics {
+	if s.metrics == nil {
+		return nil
+	}
+
+	// Services without recorded requests get zero metrics rather than none,
+	// so clients can tell idle services from disabled metrics
+	w, _ := s.metrics.Summary(service, s.summaryWindow, now)
+	w.Duration = s.summaryWindow
+	return requestMetricsToProto(w)[0]
+}
+
+// requestMetricsToProto converts windows to their API representation
+func requestMetricsToProto(windows ...metrics.Window) []*observerv1.RequestMetrics {
+	result := make([]*observerv1.RequestMetrics, 0, len(windows))
+	for _, w := range windows {
+		result = append(result, &observerv1.RequestMetrics{
+			WindowSeconds: int64(w.Duration / time.Second),
+			Requests:      w.Requests,
+			Errors:        w.Errors,
+			RequestRate:   w.Rate,
+			ErrorRate:     w.ErrorRate,
+			P50Ms:         durationMs(w.P50),
+			P95Ms:         durationMs(w.P95),
+			P99Ms:         durationMs(w.P99),
+		})
+	}
+	return result
+}
+
+// durationMs converts a duration to fractional milliseconds
+func durationMs(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
@@ -0,0 +1,80 @@
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jumppad-labs/lattice/internal/metrics"
+	latticeserf "github.com/jumppad-labs/lattice/internal/serf"
+	observerv1 "github.com/jumppad-labs/lattice/pkg/api/observer/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestObserverService_GetServiceMetrics(t *testing.T) {
+	mesh, err := latticeserf.NewMesh(latticeserf.MeshConfig{
+		NodeName: "test-lattice",
+	})
+	require.NoError(t, err)
+	svc := NewObserverService(mesh)
+
+	_, err = svc.GetServiceMetrics(context.Background(), connect.NewRequest(&observerv1.GetServiceMetricsRequest{}))
+	require.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+
+	recorder, err := metrics.NewRecorder(metrics.Options{
+		Windows: []time.Duration{time.Minute, 5 * time.Minute},
+	})
+	require.NoError(t, err)
+	svc.SetMetrics(recorder, time.Minute)
+
+	now := time.Now()
+	recorder.Record("api", []metrics.Sample{
+		{Method: "GET", Path: "/users", Status: 200, Duration: 10 * time.Millisecond, Time: now},
+		{Method: "GET", Path: "/users", Status: 500, Duration: 20 * time.Millisecond, Time: now},
+		{Method: "POST", Path: "/users", Status: 201, Duration: 30 * time.Millisecond, Time: now},
+		{Method: "POST", Path: "/users", Status: 201, Duration: 30 * time.Millisecond, Time: now},
+	}, now)
+
+	resp, err := svc.GetServiceMetrics(context.Background(), connect.NewRequest(&observerv1.GetServiceMetricsRequest{
+		IncludeRoutes: true,
+	}))
+	require.NoError(t, err)
+	require.Len(t, resp.Msg.Services, 1)
+
+	api := resp.Msg.Services[0]
+	require.Equal(t, "api", api.ServiceName)
+	require.Len(t, api.Windows, 2)
+	require.Equal(t, int64(60), api.Windows[0].WindowSeconds)
+	require.Equal(t, int64(300), api.Windows[1].WindowSeconds)
+	require.Equal(t, int64(4), api.Windows[0].Requests)
+	require.Equal(t, int64(1), api.Windows[0].Errors)
+	require.InDelta(t, 0.25, api.Windows[0].ErrorRate, 0.001)
+	require.Greater(t, api.Windows[0].P99Ms, api.Windows[0].P50Ms)
+
+	require.Len(t, api.Routes, 2)
+	require.Equal(t, "GET", api.Routes[0].Method)
+	require.Equal(t, "/users", api.Routes[0].Path)
+	require.Equal(t, int64(2), api.Routes[0].Windows[0].Requests)
+
+	// Routes are only included on request
+	resp, err = svc.GetServiceMetrics(context.Background(), connect.NewRequest(&observerv1.GetServiceMetricsRequest{
+		ServiceNames: []string{"api"},
+	}))
+	require.NoError(t, err)
+	require.Empty(t, resp.Msg.Services[0].Routes)
+
+	_, err = svc.GetServiceMetrics(context.Background(), connect.NewRequest(&observerv1.GetServiceMetricsRequest{
+		ServiceNames: []string{"missing"},
+	}))
+	require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+
+	summary := svc.metricsSummary("api", now)
+	require.Equal(t, int64(60), summary.WindowSeconds)
+	require.Equal(t, int64(4), summary.Requests)
+
+	// Services without requests have zero metrics
+	summary = svc.metricsSummary("idle", now)
+	require.Equal(t, int64(60), summary.WindowSeconds)
+	require.Zero(t, summary.Requests)
+}
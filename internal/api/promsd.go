@@ -0,0 +1,141 @@
+package api
+
+import (
+	"cmp"
+	"encoding/json"
+	"net"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	observerv1 "github.com/jumppad-labs/lattice/pkg/api/observer/v1"
+)
+
+// Labels attached to service discovery targets. As with Prometheus' built-in
+// discovery mechanisms they carry the __meta_ prefix, so they are only
+// kept if relabeling copies them to target labels.
+const (
+	sdLabelService   = "__meta_lattice_service"
+	sdLabelType      = "__meta_lattice_service_type"
+	sdLabelNode      = "__meta_lattice_node"
+	sdLabelTagPrefix = "__meta_lattice_tag_"
+)
+
+// PrometheusSDOptions filters and rewrites service discovery targets
+type PrometheusSDOptions struct {
+	// Types limits targets to these service types. Empty matches all.
+	Types []string
+
+	// Tags limits targets to services with all of these tags
+	Tags map[string]string
+
+	// PortMap replaces specific target ports, e.g. a service port with the
+	// port its metrics are served on
+	PortMap map[int]int
+
+	// Port replaces the port of every target not in PortMap. Zero keeps it.
+	Port int
+}
+
+// sdTargetGroup is an entry of the Prometheus HTTP service discovery format
+type sdTargetGroup struct {
+	Targets []string          `json:"targets"`
+	Labels  map[string]string `json:"labels"`
+}
+
+// PrometheusSDHandler serves the services in the topology as targets in
+// the Prometheus http_sd_configs format
+func (s *ObserverService) PrometheusSDHandler(opts PrometheusSDOptions) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		groups := s.prometheusTargets(s.buildTopology(), opts)
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(groups)
+	})
+}
+
+// prometheusTargets builds a target group for each service matching opts
+func (s *ObserverService) prometheusTargets(topology *observerv1.Topology, opts PrometheusSDOptions) []sdTargetGroup {
+	nodeAddrs := s.nodeAddrs()
+
+	groups := make([]sdTargetGroup, 0, len(topology.Services))
+	for _, svc := range topology.Services {
+		if len(opts.Types) > 0 && !slices.Contains(opts.Types, svc.Type) {
+			continue
+		}
+		if !hasTags(svc.Tags, opts.Tags) {
+			continue
+		}
+
+		target, ok := sdTarget(svc.Address, nodeAddrs[svc.NodeName], opts)
+		if !ok {
+			continue
+		}
+
+		labels := map[string]string{
+			sdLabelService: svc.Name,
+			sdLabelType:    svc.Type,
+			sdLabelNode:    svc.NodeName,
+		}
+		for key, value := range svc.Tags {
+			// The services tag is the JSON service list this target came from
+			if key == "services" {
+				continue
+			}
+			labels[sdLabelTagPrefix+sanitizeLabelName(key)] = value
+		}
+
+		groups = append(groups, sdTargetGroup{
+			Targets: []string{target},
+			Labels:  labels,
+		})
+	}
+
+	slices.SortFunc(groups, func(a, b sdTargetGroup) int {
+		return cmp.Or(
+			cmp.Compare(a.Labels[sdLabelService], b.Labels[sdLabelService]),
+			cmp.Compare(a.Labels[sdLabelNode], b.Labels[sdLabelNode]),
+		)
+	})
+
+	return groups
+}
+
+// sdTarget returns the host:port to scrape for a service address, applying
+// the port rewrites. ok is false if the address has no usable port.
+func sdTarget(addr, nodeAddr string, opts PrometheusSDOptions) (string, bool) {
+	host, port, ok := serviceEndpoint(addr, nodeAddr)
+	if !ok {
+		return "", false
+	}
+
+	if mapped, ok := opts.PortMap[port]; ok {
+		port = mapped
+	} else if opts.Port != 0 {
+		port = opts.Port
+	}
+
+	return net.JoinHostPort(host, strconv.Itoa(port)), true
+}
+
+// hasTags reports whether tags contains every key and value in want
+func hasTags(tags, want map[string]string) bool {
+	for key, value := range want {
+		if v, ok := tags[key]; !ok || v != value {
+			return false
+		}
+	}
+	return true
+}
+
+// sanitizeLabelName replaces characters that are not allowed in Prometheus
+// label names with underscores
+func sanitizeLabelName(name string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '_' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+			return r
+		}
+		return '_'
+	}, name)
+}
@@ -0,0 +1,97 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+
+	latticeserf "github.com/jumppad-labs/lattice/internal/serf"
+	observerv1 "github.com/jumppad-labs/lattice/pkg/api/observer/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrometheusTargets(t *testing.T) {
+	mesh, err := latticeserf.NewMesh(latticeserf.MeshConfig{
+		NodeName: "test-lattice",
+	})
+	require.NoError(t, err)
+	svc := NewObserverService(mesh)
+
+	orders := testService("node2", "orders")
+	orders.Tags = map[string]string{"env": "prod", "services": "[...]", "team.name": "shop"}
+	db := testService("node2", "orders-db")
+	db.Type = "postgres"
+	db.Address = "10.0.0.2:5432"
+	unbound := testService("node3", "unbound")
+	unbound.Address = "0.0.0.0:8080"
+
+	topology := &observerv1.Topology{
+		Services: []*observerv1.Service{
+			testService("node1", "users"),
+			orders,
+			db,
+			unbound,
+			{Name: "no-port", Type: "http", Address: "10.0.0.4", NodeName: "node4"},
+		},
+	}
+
+	// Unspecified hosts are only usable if the node address is known
+	groups := svc.prometheusTargets(topology, PrometheusSDOptions{})
+	require.Len(t, groups, 3)
+	require.Equal(t, []string{"10.0.0.1:8080"}, groups[0].Targets)
+	require.Equal(t, map[string]string{
+		"__meta_lattice_service":       "orders",
+		"__meta_lattice_service_type":  "http",
+		"__meta_lattice_node":          "node2",
+		"__meta_lattice_tag_env":       "prod",
+		"__meta_lattice_tag_team_name": "shop",
+	}, groups[0].Labels)
+	require.Equal(t, "orders-db", groups[1].Labels[sdLabelService])
+	require.Equal(t, "users", groups[2].Labels[sdLabelService])
+
+	groups = svc.prometheusTargets(topology, PrometheusSDOptions{
+		Types: []string{"http"},
+		Tags:  map[string]string{"env": "prod"},
+	})
+	require.Len(t, groups, 1)
+	require.Equal(t, "orders", groups[0].Labels[sdLabelService])
+
+	groups = svc.prometheusTargets(topology, PrometheusSDOptions{
+		PortMap: map[int]int{5432: 9187},
+		Port:    9090,
+	})
+	require.Equal(t, []string{"10.0.0.1:9090"}, groups[0].Targets)
+	require.Equal(t, []string{"10.0.0.2:9187"}, groups[1].Targets)
+}
+
+func TestSDTarget(t *testing.T) {
+	target, ok := sdTarget(":8080", "192.168.1.5", PrometheusSDOptions{})
+	require.True(t, ok)
+	require.Equal(t, "192.168.1.5:8080", target)
+
+	target, ok = sdTarget("[::]:8080", "fd00::1", PrometheusSDOptions{})
+	require.True(t, ok)
+	require.Equal(t, "[fd00::1]:8080", target)
+
+	_, ok = sdTarget("0.0.0.0:8080", "", PrometheusSDOptions{})
+	require.False(t, ok)
+}
+
+func TestPrometheusSDHandler(t *testing.T) {
+	mesh, err := latticeserf.NewMesh(latticeserf.MeshConfig{
+		NodeName: "test-lattice",
+	})
+	require.NoError(t, err)
+	svc := NewObserverService(mesh)
+
+	rec := httptest.NewRecorder()
+	svc.PrometheusSDHandler(PrometheusSDOptions{}).ServeHTTP(rec, httptest.NewRequest("GET", "/prometheus/targets", nil))
+
+	require.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+
+	// An empty mesh is an empty list, not null
+	var groups []sdTargetGroup
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &groups))
+	require.NotNil(t, groups)
+	require.Empty(t, groups)
+}
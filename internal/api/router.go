@@ -0,0 +1,351 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	latticeserf "github.com/jumppad-labs/lattice/internal/serf"
+	"github.com/jumppad-labs/lattice/internal/telemetry"
+	"github.com/jumppad-labs/lattice/pkg/api/meta/v1/metaapiconnect"
+	observerv1 "github.com/jumppad-labs/lattice/pkg/api/observer/v1"
+)
+
+const (
+	// defaultRouteMaxPaths is how many disjoint mesh paths are tried before a
+	// proxied call fails
+	defaultRouteMaxPaths = 3
+
+	// defaultRouteAttemptTimeout bounds each attempt so a dead path fails
+	// fast and the next one is tried
+	defaultRouteAttemptTimeout = 5 * time.Second
+
+	// defaultRouteCallTimeout bounds a proxied call across all attempts
+	defaultRouteCallTimeout = 15 * time.Second
+)
+
+// Headers describing how a proxied call was routed through the mesh
+const (
+	// routeHeader is the path that succeeded, as comma separated node names
+	routeHeader = "Lattice-Route"
+
+	// routeAttemptsHeader is the number of paths that were tried
+	routeAttemptsHeader = "Lattice-Route-Attempts"
+
+	// routeErrorHeader is repeated for each failed attempt as "path: error"
+	routeErrorHeader = "Lattice-Route-Error"
+)
+
+var (
+	// ErrServiceNotFound is returned when the target service is not in the topology
+	ErrServiceNotFound = errors.New("service not found")
+
+	// ErrNoRoute is returned when the mesh graph has no path to the target node
+	ErrNoRoute = errors.New("no route to node")
+)
+
+// RouteError is returned when every path to a node failed
+type RouteError struct {
+	NodeName string
+	Attempts []*observerv1.RouteAttempt
+}
+
+func (e *RouteError) Error() string {
+	return fmt.Sprintf("all %d paths to node %q failed", len(e.Attempts), e.NodeName)
+}
+
+// UpstreamError is returned when the target service rejected the call. The
+// rejection is not caused by the path, so other paths are not tried.
+type UpstreamError struct {
+	Service string
+	Path    []string
+	Err     *connect.Error
+}
+
+func (e *UpstreamError) Error() string {
+	return fmt.Sprintf("service %q: %s", e.Service, e.Err.Message())
+}
+
+func (e *UpstreamError) Unwrap() error {
+	return e.Err
+}
+
+// Route describes how a proxied call was routed
+type Route struct {
+	// Path is the path that succeeded, starting at the local node
+	Path []string
+
+	// Attempts lists every path tried, in order
+	Attempts []*observerv1.RouteAttempt
+}
+
+// SetHeaders reports the path taken and any failed attempts
+func (r *Route) SetHeaders(header http.Header) {
+	if r == nil {
+		return
+	}
+
+	if r.Path != nil {
+		header.Set(routeHeader, strings.Join(r.Path, ","))
+	}
+	header.Set(routeAttemptsHeader, strconv.Itoa(len(r.Attempts)))
+
+	for _, attempt := range r.Attempts {
+		if attempt.Error != "" {
+			// Header values can't contain line breaks
+			msg := strings.NewReplacer("\r", " ", "\n", " ").Replace(attempt.Error)
+			header.Add(routeErrorHeader, strings.Join(attempt.Path, ",")+": "+msg)
+		}
+	}
+}
+
+// MetaCall calls PolymorphMetaService on an entry node. The path excludes
+// the local node and starts at the entry node.
+type MetaCall func(ctx context.Context, client metaapiconnect.PolymorphMetaServiceClient, path []string) error
+
+// MeshRouter calls PolymorphMetaService RPCs on services anywhere in the
+// mesh. Calls enter the mesh at the first node of a path and are forwarded
+// by Polymorph; if a path fails the next disjoint path is tried.
+type MeshRouter struct {
+	mesh     *latticeserf.Mesh
+	topology func() *observerv1.Topology
+	client   *http.Client
+
+	maxPaths       int
+	attemptTimeout time.Duration
+	callTimeout    time.Duration
+
+	// Telemetry for calls by target service
+	calls        *telemetry.CounterVec
+	callDuration *telemetry.HistogramVec
+}
+
+// NewMeshRouter creates a MeshRouter that resolves services and entry node
+// addresses from the given topology source
+func NewMeshRouter(mesh *latticeserf.Mesh, topology func() *observerv1.Topology) *MeshRouter {
+	return &MeshRouter{
+		mesh:           mesh,
+		topology:       topology,
+		client:         &http.Client{Transport: newMetaTransport()},
+		maxPaths:       defaultRouteMaxPaths,
+		attemptTimeout: defaultRouteAttemptTimeout,
+		callTimeout:    defaultRouteCallTimeout,
+		calls: telemetry.NewCounterVec("lattice_proxy_requests_total",
+			"Calls proxied to services through the mesh, by target service and Connect code.",
+			"target", "code"),
+		callDuration: telemetry.NewHistogramVec("lattice_proxy_request_duration_seconds",
+			"Duration of calls proxied to services through the mesh, including failover.",
+			telemetry.DefaultBuckets, "target"),
+	}
+}
+
+// newMetaTransport returns a transport that keeps connections to entry
+// nodes open between calls
+func newMetaTransport() *http.Transport {
+	return &http.Transport{
+		DialContext: (&net.Dialer{
+			Timeout:   3 * time.Second,
+			KeepAlive: 30 * time.Second,
+		}).DialContext,
+		MaxIdleConns:          100,
+		MaxIdleConnsPerHost:   10,
+		IdleConnTimeout:       90 * time.Second,
+		ResponseHeaderTimeout: defaultRouteAttemptTimeout,
+	}
+}
+
+// Call calls the named service, trying disjoint mesh paths in order until
+// one succeeds. The returned Route is set whenever at least one path was
+// tried, including on failure.
+func (r *MeshRouter) Call(ctx context.Context, serviceName string, call MetaCall) (*Route, error) {
+	start := time.Now()
+	route, err := r.call(ctx, serviceName, call)
+
+	code := "ok"
+	if err != nil {
+		code = connect.CodeOf(routerError(err, nil)).String()
+	}
+
+	// Don't create series for names that aren't services
+	target := serviceName
+	if errors.Is(err, ErrServiceNotFound) {
+		target = ""
+	}
+
+	r.calls.Inc(target, code)
+	r.callDuration.Observe(time.Since(start).Seconds(), target)
+
+	return route, err
+}
+
+// Collect writes the call telemetry
+func (r *MeshRouter) Collect(w *telemetry.Writer) {
+	r.calls.Collect(w)
+	r.callDuration.Collect(w)
+}
+
+// call implements Call
+func (r *MeshRouter) call(ctx context.Context, serviceName string, call MetaCall) (*Route, error) {
+	topology := r.topology()
+
+	target := findService(topology, serviceName)
+	if target == nil {
+		return nil, fmt.Errorf("%w: %q", ErrServiceNotFound, serviceName)
+	}
+
+	paths := r.mesh.Routes(target.NodeName, r.maxPaths)
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("%w %q", ErrNoRoute, target.NodeName)
+	}
+
+	// Entry nodes are reached at their service address
+	addrs := make(map[string]string)
+	for _, svc := range topology.Services {
+		if _, ok := addrs[svc.NodeName]; !ok && svc.Address != "" {
+			addrs[svc.NodeName] = svc.Address
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, r.callTimeout)
+	defer cancel()
+
+	route := &Route{}
+	for _, path := range paths {
+		start := time.Now()
+		err := r.attempt(ctx, addrs, path, call)
+
+		attempt := &observerv1.RouteAttempt{
+			Path:       path,
+			DurationMs: time.Since(start).Milliseconds(),
+		}
+		if err == nil {
+			route.Path = path
+			route.Attempts = append(route.Attempts, attempt)
+			return route, nil
+		}
+
+		attempt.Error = err.Error()
+		route.Attempts = append(route.Attempts, attempt)
+
+		// The service itself rejected the call, other paths won't help
+		var connectErr *connect.Error
+		if errors.As(err, &connectErr) && connect.IsWireError(connectErr) && !retryable(connectErr.Code()) {
+			return route, &UpstreamError{Service: serviceName, Path: path, Err: connectErr}
+		}
+
+		// The caller gave up or the call deadline passed
+		if ctx.Err() != nil {
+			return route, ctx.Err()
+		}
+	}
+
+	return route, &RouteError{NodeName: target.NodeName, Attempts: route.Attempts}
+}
+
+// attempt makes a single call over one path with the attempt timeout
+func (r *MeshRouter) attempt(ctx context.Context, addrs map[string]string, path []string, call MetaCall) error {
+	if len(path) < 2 {
+		return fmt.Errorf("invalid path: %v", path)
+	}
+
+	// Skip the local node, the first Polymorph node is the entry point
+	entryNode := path[1]
+	entryAddr := addrs[entryNode]
+	if entryAddr == "" {
+		return fmt.Errorf("no service address for entry node %q", entryNode)
+	}
+
+	attemptCtx, cancel := context.WithTimeout(ctx, r.attemptTimeout)
+	defer cancel()
+
+	// Polymorph serves the meta API as Connect with JSON
+	client := metaapiconnect.NewPolymorphMetaServiceClient(r.client, "http://"+entryAddr, connect.WithProtoJSON())
+
+	err := call(attemptCtx, client, path[1:])
+
+	// The deadline is propagated, so the entry node may report it first
+	timedOut := attemptCtx.Err() == context.DeadlineExceeded || connect.CodeOf(err) == connect.CodeDeadlineExceeded
+	if err != nil && timedOut && ctx.Err() == nil {
+		return fmt.Errorf("attempt timed out after %s: %w", r.attemptTimeout, err)
+	}
+	return err
+}
+
+// retryable reports whether an error code returned by a service may be
+// caused by the path, so another path could succeed
+func retryable(code connect.Code) bool {
+	switch code {
+	case connect.CodeUnavailable,
+		connect.CodeDeadlineExceeded,
+		connect.CodeResourceExhausted,
+		connect.CodeAborted,
+		connect.CodeInternal,
+		connect.CodeUnknown:
+		return true
+	default:
+		return false
+	}
+}
+
+// callMeta makes a proxied unary call with a request built for each path
+func callMeta[Req, Res any](
+	ctx context.Context,
+	router *MeshRouter,
+	serviceName string,
+	method func(metaapiconnect.PolymorphMetaServiceClient, context.Context, *connect.Request[Req]) (*connect.Response[Res], error),
+	newRequest func(path []string) *Req,
+) (*Res, *Route, error) {
+	var res *Res
+	route, err := router.Call(ctx, serviceName,
+		func(ctx context.Context, client metaapiconnect.PolymorphMetaServiceClient, path []string) error {
+			resp, err := method(client, ctx, connect.NewRequest(newRequest(path)))
+			if err != nil {
+				return err
+			}
+			res = resp.Msg
+			return nil
+		})
+	return res, route, err
+}
+
+// routerError maps MeshRouter errors to Connect errors. Route details are
+// returned as headers and, when every path failed, a RouteFailure detail.
+func routerError(err error, route *Route) error {
+	var (
+		connectErr *connect.Error
+		routeErr   *RouteError
+		upstream   *UpstreamError
+	)
+
+	switch {
+	case errors.Is(err, ErrServiceNotFound):
+		connectErr = connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, ErrNoRoute):
+		connectErr = connect.NewError(connect.CodeUnavailable, err)
+	case errors.As(err, &routeErr):
+		connectErr = connect.NewError(connect.CodeUnavailable, err)
+		if detail, detailErr := connect.NewErrorDetail(&observerv1.RouteFailure{
+			NodeName: routeErr.NodeName,
+			Attempts: routeErr.Attempts,
+		}); detailErr == nil {
+			connectErr.AddDetail(detail)
+		}
+	case errors.As(err, &upstream):
+		connectErr = connect.NewError(upstream.Err.Code(), errors.New(upstream.Error()))
+	case errors.Is(err, context.DeadlineExceeded):
+		connectErr = connect.NewError(connect.CodeDeadlineExceeded, err)
+	case errors.Is(err, context.Canceled):
+		connectErr = connect.NewError(connect.CodeCanceled, err)
+	default:
+		connectErr = connect.NewError(connect.CodeInternal, err)
+	}
+
+	route.SetHeaders(connectErr.Meta())
+	return connectErr
+}
@@ -0,0 +1,200 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	latticeserf "github.com/jumppad-labs/lattice/internal/serf"
+	"github.com/jumppad-labs/lattice/internal/telemetry"
+	metav1 "github.com/jumppad-labs/lattice/pkg/api/meta/v1"
+	"github.com/jumppad-labs/lattice/pkg/api/meta/v1/metaapiconnect"
+	observerv1 "github.com/jumppad-labs/lattice/pkg/api/observer/v1"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeMeta is a Polymorph node serving the meta API
+type fakeMeta struct {
+	metaapiconnect.UnimplementedPolymorphMetaServiceHandler
+
+	err   error
+	hang  bool
+	paths chan []string
+}
+
+func (f *fakeMeta) GetRequestLogs(
+	ctx context.Context,
+	req *connect.Request[metav1.GetRequestLogsRequest],
+) (*connect.Response[metav1.GetRequestLogsResponse], error) {
+	f.paths <- req.Msg.Path
+	if f.hang {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+	if f.err != nil {
+		return nil, f.err
+	}
+	return connect.NewResponse(&metav1.GetRequestLogsResponse{
+		Logs:           []*metav1.RequestLog{{Sequence: 7, Method: "GET", Path: "/users", Status: 200}},
+		LatestSequence: 7,
+	}), nil
+}
+
+// startMeta serves a fake meta API and returns its address
+func startMeta(t *testing.T, f *fakeMeta) string {
+	f.paths = make(chan []string, 10)
+	_, handler := metaapiconnect.NewPolymorphMetaServiceHandler(f)
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	return strings.TrimPrefix(server.URL, "http://")
+}
+
+// newTestRouter returns a router whose mesh graph has two disjoint paths to
+// node c, lattice-a-c and lattice-b-d-c. Service "target" runs on c.
+func newTestRouter(t *testing.T, addrA, addrB string) *MeshRouter {
+	mesh, err := latticeserf.NewMesh(latticeserf.MeshConfig{
+		NodeName: "lattice",
+	})
+	require.NoError(t, err)
+
+	graph := mesh.Graph()
+	require.NoError(t, graph.Update([]byte(`{"n":"a","nb":["lattice","c"]}`)))
+	require.NoError(t, graph.Update([]byte(`{"n":"b","nb":["lattice","d"]}`)))
+	require.NoError(t, graph.Update([]byte(`{"n":"d","nb":["b","c"]}`)))
+
+	svcA := testService("a", "a-svc")
+	svcA.Address = addrA
+	svcB := testService("b", "b-svc")
+	svcB.Address = addrB
+	topology := &observerv1.Topology{
+		Services: []*observerv1.Service{svcA, svcB, testService("c", "target")},
+	}
+
+	return NewMeshRouter(mesh, func() *observerv1.Topology { return topology })
+}
+
+func TestMeshRouterFailover(t *testing.T) {
+	a := &fakeMeta{err: connect.NewError(connect.CodeUnavailable, errors.New("next hop unreachable"))}
+	b := &fakeMeta{}
+	router := newTestRouter(t, startMeta(t, a), startMeta(t, b))
+
+	resp, route, err := callMeta(context.Background(), router, "target",
+		metaapiconnect.PolymorphMetaServiceClient.GetRequestLogs,
+		func(path []string) *metav1.GetRequestLogsRequest {
+			return &metav1.GetRequestLogsRequest{ServiceName: "target", Path: path}
+		})
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), resp.LatestSequence)
+
+	require.Equal(t, []string{"a", "c"}, <-a.paths)
+	require.Equal(t, []string{"b", "d", "c"}, <-b.paths)
+
+	require.Equal(t, []string{"lattice", "b", "d", "c"}, route.Path)
+	require.Len(t, route.Attempts, 2)
+	require.Contains(t, route.Attempts[0].Error, "next hop unreachable")
+
+	header := http.Header{}
+	route.SetHeaders(header)
+	require.Equal(t, "lattice,b,d,c", header.Get(routeHeader))
+	require.Equal(t, "2", header.Get(routeAttemptsHeader))
+	require.Len(t, header.Values(routeErrorHeader), 1)
+}
+
+func TestMeshRouterUpstreamError(t *testing.T) {
+	a := &fakeMeta{err: connect.NewError(connect.CodeNotFound, errors.New("no such service"))}
+	b := &fakeMeta{}
+	router := newTestRouter(t, startMeta(t, a), startMeta(t, b))
+
+	route, err := router.Call(context.Background(), "target",
+		func(ctx context.Context, client metaapiconnect.PolymorphMetaServiceClient, path []string) error {
+			_, err := client.GetRequestLogs(ctx, connect.NewRequest(&metav1.GetRequestLogsRequest{Path: path}))
+			return err
+		})
+
+	// A rejection by the service is not retried on another path
+	var upstream *UpstreamError
+	require.ErrorAs(t, err, &upstream)
+	require.Len(t, route.Attempts, 1)
+	require.Empty(t, b.paths)
+
+	require.Equal(t, connect.CodeNotFound, connect.CodeOf(routerError(err, route)))
+}
+
+func TestMeshRouterAllPathsFail(t *testing.T) {
+	a := &fakeMeta{hang: true}
+	router := newTestRouter(t, startMeta(t, a), "127.0.0.1:1")
+	router.attemptTimeout = 100 * time.Millisecond
+
+	route, err := router.Call(context.Background(), "target",
+		func(ctx context.Context, client metaapiconnect.PolymorphMetaServiceClient, path []string) error {
+			_, err := client.GetRequestLogs(ctx, connect.NewRequest(&metav1.GetRequestLogsRequest{Path: path}))
+			return err
+		})
+
+	var routeErr *RouteError
+	require.ErrorAs(t, err, &routeErr)
+	require.Equal(t, "c", routeErr.NodeName)
+	require.Len(t, routeErr.Attempts, 2)
+	require.Contains(t, routeErr.Attempts[0].Error, "timed out")
+
+	connectErr := new(connect.Error)
+	require.ErrorAs(t, routerError(err, route), &connectErr)
+	require.Equal(t, connect.CodeUnavailable, connectErr.Code())
+	require.Equal(t, "2", connectErr.Meta().Get(routeAttemptsHeader))
+	require.Len(t, connectErr.Meta().Values(routeErrorHeader), 2)
+
+	require.Len(t, connectErr.Details(), 1)
+	detail, err := connectErr.Details()[0].Value()
+	require.NoError(t, err)
+	failure, ok := detail.(*observerv1.RouteFailure)
+	require.True(t, ok)
+	require.Equal(t, []string{"lattice", "a", "c"}, failure.Attempts[0].Path)
+}
+
+func TestMeshRouterResolveErrors(t *testing.T) {
+	router := newTestRouter(t, "127.0.0.1:1", "127.0.0.1:1")
+	noCall := func(ctx context.Context, client metaapiconnect.PolymorphMetaServiceClient, path []string) error {
+		t.Fatal("unexpected call")
+		return nil
+	}
+
+	route, err := router.Call(context.Background(), "unknown", noCall)
+	require.ErrorIs(t, err, ErrServiceNotFound)
+	require.Equal(t, connect.CodeNotFound, connect.CodeOf(routerError(err, route)))
+
+	router.topology = func() *observerv1.Topology {
+		return &observerv1.Topology{Services: []*observerv1.Service{testService("z", "isolated")}}
+	}
+	route, err = router.Call(context.Background(), "isolated", noCall)
+	require.ErrorIs(t, err, ErrNoRoute)
+	require.Equal(t, connect.CodeUnavailable, connect.CodeOf(routerError(err, route)))
+
+	// Calls are counted by target and code, without a series per unknown name
+	rec := httptest.NewRecorder()
+	telemetry.Handler(router).ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+	require.Contains(t, rec.Body.String(), `lattice_proxy_requests_total{target="",code="not_found"} 1`)
+	require.Contains(t, rec.Body.String(), `lattice_proxy_requests_total{target="isolated",code="unavailable"} 1`)
+	require.Contains(t, rec.Body.String(), `lattice_proxy_request_duration_seconds_count{target="isolated"} 1`)
+}
+
+func TestObserverService_GetRequestLogsRouted(t *testing.T) {
+	a := &fakeMeta{}
+	router := newTestRouter(t, startMeta(t, a), "127.0.0.1:1")
+
+	svc := NewObserverService(router.mesh)
+	svc.router = router
+
+	resp, err := svc.GetRequestLogs(context.Background(), connect.NewRequest(&observerv1.GetRequestLogsRequest{
+		ServiceName: "target",
+	}))
+	require.NoError(t, err)
+	require.Equal(t, "lattice,a,c", resp.Header().Get(routeHeader))
+	require.Len(t, resp.Msg.Logs, 1)
+	require.Equal(t, "/users", resp.Msg.Logs[0].Path)
+	require.Equal(t, uint64(7), resp.Msg.LatestSequence)
+}
@@ -1,47 +1,124 @@
 package api
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
-	"net/http"
+	"maps"
+	"slices"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"connectrpc.com/connect"
 	"github.com/hashicorp/serf/serf"
+	metav1 "github.com/jumppad-labs/lattice/pkg/api/meta/v1"
+	"github.com/jumppad-labs/lattice/pkg/api/meta/v1/metaapiconnect"
 	observerv1 "github.com/jumppad-labs/lattice/pkg/api/observer/v1"
 	"github.com/jumppad-labs/lattice/pkg/api/observer/v1/observerapiconnect"
+	"github.com/jumppad-labs/lattice/internal/health"
+	"github.com/jumppad-labs/lattice/internal/history"
+	"github.com/jumppad-labs/lattice/internal/logstore"
+	"github.com/jumppad-labs/lattice/internal/metrics"
 	latticeserf "github.com/jumppad-labs/lattice/internal/serf"
-	"google.golang.org/protobuf/encoding/protojson"
 )
 
 // ObserverService implements the Observer API
 type ObserverService struct {
-	mesh      *latticeserf.Mesh
-	mu        sync.RWMutex
-	watchers  map[chan *observerv1.TopologyUpdate]struct{}
+	mesh     *latticeserf.Mesh
+	mu       sync.RWMutex
+	watchers map[*topologyWatcher]struct{}
+
+	// Last published topology and its revision, used to compute deltas
+	current  *observerv1.Topology
+	revision uint64
+
+	// Pending debounced notification
+	notifyMu      sync.Mutex
+	notifyTimer   *time.Timer
+	notifyPending time.Time
+
+	// router proxies calls to Polymorph services through the mesh
+	router *MeshRouter
+
+	// logs shares request log subscriptions among WatchRequestLogs streams
+	logs *logHub
+
+	// logStore retains collected request logs for SearchRequestLogs, nil if
+	// retention is disabled
+	logStore *logstore.Store
+
+	// metrics computes request metrics from collected logs, nil if metrics
+	// are disabled. summaryWindow is the window reported on each service.
+	metrics       *metrics.Recorder
+	summaryWindow time.Duration
+
+	// checker runs active health checks, nil if none are configured.
+	// passiveHealth derives health from error rates, nil if disabled.
+	checker       *health.Checker
+	healthChecks  map[string]health.Check
+	passiveHealth *health.Passive
+
+	// history records published revisions, nil if disabled
+	history *history.Store
 }
 
-// NewObserverService creates a new ObserverService
+const (
+	// notifyDebounce is how long to wait for further mesh events before
+	// publishing, so a burst of gossip results in a single update
+	notifyDebounce = 100 * time.Millisecond
+
+	// notifyMaxDelay bounds how long a continuous stream of mesh events
+	// can defer publishing
+	notifyMaxDelay = time.Second
+)
+
+// topologyWatcher is a WatchTopology stream waiting for updates
+type topologyWatcher struct {
+	ch          chan *observerv1.TopologyUpdate
+	incremental bool
+
+	// resync is set when an update was dropped because the watcher fell
+	// behind; the watcher then sends a full snapshot instead
+	resync atomic.Bool
+}
+
+// NewObserverService creates a new ObserverService. Optional features are
+// enabled with the Set methods, after which Start subscribes the service to
+// mesh events.
 func NewObserverService(mesh *latticeserf.Mesh) *ObserverService {
 	svc := &ObserverService{
 		mesh:     mesh,
-		watchers: make(map[chan *observerv1.TopologyUpdate]struct{}),
+		watchers: make(map[*topologyWatcher]struct{}),
 	}
+	svc.router = NewMeshRouter(mesh, svc.buildTopology)
+	svc.logs = newLogHub(func(ctx context.Context, service string, afterSequence uint64, limit int32) (*observerv1.GetRequestLogsResponse, error) {
+		resp, _, err := svc.fetchRequestLogs(ctx, service, afterSequence, limit)
+		return resp, err
+	})
+
+	return svc
+}
 
-	// Register callbacks for mesh events
-	mesh.OnJoin(func(member *latticeserf.Member) {
-		svc.notifyWatchers()
+// Start publishes topology updates on mesh events. The Set methods must not
+// be called after Start, as the fields they set are read without locking
+// from then on.
+func (s *ObserverService) Start() {
+	s.mesh.OnJoin(func(member *latticeserf.Member) {
+		s.scheduleNotify()
 	})
 
-	mesh.OnLeave(func(member *latticeserf.Member) {
-		svc.notifyWatchers()
+	s.mesh.OnLeave(func(member *latticeserf.Member) {
+		s.scheduleNotify()
 	})
 
-	return svc
+	s.mesh.OnUpdate(func(member *latticeserf.Member) {
+		s.scheduleNotify()
+	})
+
+	s.mesh.OnTopologyChange(func() {
+		s.scheduleNotify()
+	})
 }
 
 // Verify interface implementation
@@ -58,6 +135,16 @@ func (s *ObserverService) GetTopology(
 		Topology: topology,
 	}
 
+	if req.Msg.IncludeGraph {
+		edges := s.mesh.Graph().Edges()
+		for _, node := range slices.Sorted(maps.Keys(edges)) {
+			resp.Graph = append(resp.Graph, &observerv1.GraphNode{
+				Node:      node,
+				Neighbors: edges[node],
+			})
+		}
+	}
+
 	return connect.NewResponse(resp), nil
 }
 
@@ -67,39 +154,71 @@ func (s *ObserverService) WatchTopology(
 	req *connect.Request[observerv1.WatchTopologyRequest],
 	stream *connect.ServerStream[observerv1.TopologyUpdate],
 ) error {
-	// Send initial topology
-	initialTopology := s.buildTopology()
-	if err := stream.Send(&observerv1.TopologyUpdate{
-		Topology:   initialTopology,
-		UpdateType: observerv1.UpdateType_UPDATE_TYPE_FULL,
-	}); err != nil {
-		return err
+	w := &topologyWatcher{
+		ch:          make(chan *observerv1.TopologyUpdate, 10),
+		incremental: req.Msg.Incremental,
 	}
 
-	// Create channel for updates
-	updateCh := make(chan *observerv1.TopologyUpdate, 10)
-
+	// Register before taking the snapshot so no revision is missed
 	s.mu.Lock()
-	s.watchers[updateCh] = struct{}{}
+	s.publishLocked(s.buildTopology())
+	s.watchers[w] = struct{}{}
+	initial := s.fullUpdateLocked()
 	s.mu.Unlock()
 
 	// Clean up on exit
 	defer func() {
 		s.mu.Lock()
-		delete(s.watchers, updateCh)
+		delete(s.watchers, w)
 		s.mu.Unlock()
-		close(updateCh)
 	}()
 
+	// Send initial topology
+	if err := stream.Send(initial); err != nil {
+		return err
+	}
+	lastRevision := initial.Revision
+
 	// Stream updates
 	for {
 		select {
 		case <-ctx.Done():
 			return nil
-		case update := <-updateCh:
+		case update := <-w.ch:
+			if w.resync.Swap(false) {
+				// Updates were dropped, skip the backlog and resend everything
+				drainUpdates(w.ch)
+				s.mu.RLock()
+				update = s.fullUpdateLocked()
+				s.mu.RUnlock()
+			}
+
+			if update.Revision <= lastRevision {
+				continue
+			}
+
+			if update.Delta != nil && update.Delta.BaseRevision != lastRevision {
+				// Delta doesn't apply to what the client has
+				s.mu.RLock()
+				update = s.fullUpdateLocked()
+				s.mu.RUnlock()
+			}
+
 			if err := stream.Send(update); err != nil {
 				return err
 			}
+			lastRevision = update.Revision
+		}
+	}
+}
+
+// drainUpdates discards any pending updates
+func drainUpdates(ch chan *observerv1.TopologyUpdate) {
+	for {
+		select {
+		case <-ch:
+		default:
+			return
 		}
 	}
 }
@@ -109,112 +228,18 @@ func (s *ObserverService) GetServiceResources(
 	ctx context.Context,
 	req *connect.Request[observerv1.GetServiceResourcesRequest],
 ) (*connect.Response[observerv1.GetServiceResourcesResponse], error) {
-	// Find the service in the current topology
-	topology := s.buildTopology()
-	var targetService *observerv1.Service
-	for _, svc := range topology.Services {
-		if svc.Name == req.Msg.ServiceName {
-			targetService = svc
-			break
-		}
-	}
-
-	if targetService == nil {
-		return nil, connect.NewError(connect.CodeNotFound,
-			fmt.Errorf("service %q not found", req.Msg.ServiceName))
-	}
-
-	// Find path to target node using topology graph
-	graph := s.mesh.Graph()
-	path := graph.FindPath("lattice", targetService.NodeName)
-
-	if path == nil {
-		return nil, connect.NewError(connect.CodeUnavailable,
-			fmt.Errorf("no route to node %q", targetService.NodeName))
-	}
-
-	// Determine entry point (first Polymorph node in path)
-	var entryNode string
-	if len(path) > 1 {
-		entryNode = path[1] // Skip "lattice", get first Polymorph node
-	} else {
-		return nil, connect.NewError(connect.CodeInternal,
-			fmt.Errorf("invalid path: %v", path))
-	}
-
-	// Find entry node's service address
-	var entryAddr string
-	for _, svc := range topology.Services {
-		if svc.NodeName == entryNode {
-			entryAddr = svc.Address
-			break
-		}
-	}
-
-	if entryAddr == "" {
-		return nil, connect.NewError(connect.CodeInternal,
-			fmt.Errorf("no service address for entry node %q", entryNode))
-	}
-
-	// Build RPC request with path
-	serviceURL := fmt.Sprintf("http://%s/meta.v1.PolymorphMetaService/GetResources", entryAddr)
-	reqBody := map[string]any{
-		"serviceName": req.Msg.ServiceName,
-		"path":        path[1:], // Remove "lattice" from path
-		"currentHop":  0,
-	}
-	reqJSON, err := json.Marshal(reqBody)
-	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
-	}
-
-	// Make HTTP POST request
-	httpReq, err := http.NewRequestWithContext(ctx, "POST", serviceURL, bytes.NewReader(reqJSON))
+	metaResp, route, err := callMeta(ctx, s.router, req.Msg.ServiceName,
+		metaapiconnect.PolymorphMetaServiceClient.GetResources,
+		func(path []string) *metav1.GetResourcesRequest {
+			return &metav1.GetResourcesRequest{ServiceName: req.Msg.ServiceName, Path: path}
+		})
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
-	}
-	httpReq.Header.Set("Content-Type", "application/json")
-
-	httpResp, err := http.DefaultClient.Do(httpReq)
-	if err != nil {
-		return nil, connect.NewError(connect.CodeUnavailable,
-			fmt.Errorf("failed to connect to Polymorph service: %w", err))
-	}
-	defer httpResp.Body.Close()
-
-	if httpResp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(httpResp.Body)
-		return nil, connect.NewError(connect.CodeInternal,
-			fmt.Errorf("Polymorph returned status %d: %s", httpResp.StatusCode, string(body)))
-	}
-
-	// Parse response
-	var lokiResp struct {
-		Services []struct {
-			ServiceName string `json:"serviceName"`
-			Resources   []struct {
-				Name       string `json:"name"`
-				RowCount   int32  `json:"rowCount"`
-				PluralName string `json:"pluralName"`
-				Fields     []struct {
-					Name   string    `json:"name"`
-					Type   string    `json:"type"`
-					Values []string  `json:"values,omitempty"`
-					Min    *float64  `json:"min,omitempty"`
-					Max    *float64  `json:"max,omitempty"`
-				} `json:"fields"`
-			} `json:"resources"`
-		} `json:"services"`
-	}
-
-	if err := json.NewDecoder(httpResp.Body).Decode(&lokiResp); err != nil {
-		return nil, connect.NewError(connect.CodeInternal,
-			fmt.Errorf("failed to parse Polymorph response: %w", err))
+		return nil, routerError(err, route)
 	}
 
 	// Convert to Lattice format
 	var resources []*observerv1.Resource
-	for _, svcRes := range lokiResp.Services {
+	for _, svcRes := range metaResp.Services {
 		for _, res := range svcRes.Resources {
 			fields := make([]*observerv1.Field, 0, len(res.Fields))
 			for _, field := range res.Fields {
@@ -236,11 +261,12 @@ func (s *ObserverService) GetServiceResources(
 		}
 	}
 
-	resp := &observerv1.GetServiceResourcesResponse{
+	resp := connect.NewResponse(&observerv1.GetServiceResourcesResponse{
 		Resources: resources,
-	}
+	})
+	route.SetHeaders(resp.Header())
 
-	return connect.NewResponse(resp), nil
+	return resp, nil
 }
 
 // GetRequestLogs fetches recent HTTP request logs for a service
@@ -248,101 +274,108 @@ func (s *ObserverService) GetRequestLogs(
 	ctx context.Context,
 	req *connect.Request[observerv1.GetRequestLogsRequest],
 ) (*connect.Response[observerv1.GetRequestLogsResponse], error) {
-	// Find the service in the current topology
-	topology := s.buildTopology()
-	var targetService *observerv1.Service
-	for _, svc := range topology.Services {
-		if svc.Name == req.Msg.ServiceName {
-			targetService = svc
-			break
-		}
-	}
-
-	if targetService == nil {
-		return nil, connect.NewError(connect.CodeNotFound,
-			fmt.Errorf("service %q not found", req.Msg.ServiceName))
-	}
-
-	// Find path to target node using topology graph
-	graph := s.mesh.Graph()
-	path := graph.FindPath("lattice", targetService.NodeName)
-
-	if path == nil {
-		return nil, connect.NewError(connect.CodeUnavailable,
-			fmt.Errorf("no route to node %q", targetService.NodeName))
-	}
-
-	// Determine entry point (first Polymorph node in path)
-	var entryNode string
-	if len(path) > 1 {
-		entryNode = path[1] // Skip "lattice", get first Polymorph node
-	} else {
-		return nil, connect.NewError(connect.CodeInternal,
-			fmt.Errorf("invalid path: %v", path))
+	logs, route, err := s.fetchRequestLogs(ctx, req.Msg.ServiceName, req.Msg.AfterSequence, req.Msg.Limit)
+	if err != nil {
+		return nil, routerError(err, route)
 	}
 
-	// Find entry node's service address
-	var entryAddr string
-	for _, svc := range topology.Services {
-		if svc.NodeName == entryNode {
-			entryAddr = svc.Address
-			break
-		}
-	}
+	resp := connect.NewResponse(logs)
+	route.SetHeaders(resp.Header())
 
-	if entryAddr == "" {
-		return nil, connect.NewError(connect.CodeInternal,
-			fmt.Errorf("no service address for entry node %q", entryNode))
-	}
+	return resp, nil
+}
 
-	// Build RPC request with path
-	serviceURL := fmt.Sprintf("http://%s/meta.v1.PolymorphMetaService/GetRequestLogs", entryAddr)
-	reqBody := map[string]any{
-		"serviceName":   req.Msg.ServiceName,
-		"afterSequence": req.Msg.AfterSequence,
-		"limit":         req.Msg.Limit,
-		"path":          path[1:], // Remove "lattice" from path
-		"currentHop":    0,
+// WatchRequestLogs streams new HTTP request logs for a service. All
+// watchers of a service share a single upstream subscription.
+func (s *ObserverService) WatchRequestLogs(
+	ctx context.Context,
+	req *connect.Request[observerv1.WatchRequestLogsRequest],
+	stream *connect.ServerStream[observerv1.WatchRequestLogsResponse],
+) error {
+	if err := validateLogFilter(req.Msg.Filter); err != nil {
+		return connect.NewError(connect.CodeInvalidArgument, err)
 	}
-	reqJSON, err := json.Marshal(reqBody)
-	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+	if findService(s.router.topology(), req.Msg.ServiceName) == nil {
+		return connect.NewError(connect.CodeNotFound,
+			fmt.Errorf("service %q not found", req.Msg.ServiceName))
 	}
 
-	// Make HTTP POST request
-	httpReq, err := http.NewRequestWithContext(ctx, "POST", serviceURL, bytes.NewReader(reqJSON))
-	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
-	}
-	httpReq.Header.Set("Content-Type", "application/json")
+	sub, watcher := s.logs.subscribe(req.Msg.ServiceName)
+	defer s.logs.unsubscribe(sub, watcher)
 
-	httpResp, err := http.DefaultClient.Do(httpReq)
-	if err != nil {
-		return nil, connect.NewError(connect.CodeUnavailable,
-			fmt.Errorf("failed to connect to Polymorph service: %w", err))
-	}
-	defer httpResp.Body.Close()
+	cursor := &logCursor{sequence: req.Msg.AfterSequence}
+	for {
+		// Send everything buffered past the cursor before waiting again
+		for resp := sub.next(cursor); resp != nil; resp = sub.next(cursor) {
+			if req.Msg.Filter != nil {
+				resp.Logs = slices.DeleteFunc(resp.Logs, func(entry *observerv1.RequestLog) bool {
+					return !matchRequestLog(req.Msg.Filter, entry)
+				})
+				if len(resp.Logs) == 0 && !resp.Restarted && !resp.Truncated {
+					continue
+				}
+			}
+			if err := stream.Send(resp); err != nil {
+				return err
+			}
+		}
 
-	if httpResp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(httpResp.Body)
-		return nil, connect.NewError(connect.CodeInternal,
-			fmt.Errorf("Polymorph returned status %d: %s", httpResp.StatusCode, string(body)))
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-watcher.notify:
+		}
 	}
+}
 
-	// Parse response using protobuf JSON unmarshaler (handles uint64/enum strings)
-	body, err := io.ReadAll(httpResp.Body)
+// fetchRequestLogs fetches request logs from a service through the mesh
+func (s *ObserverService) fetchRequestLogs(
+	ctx context.Context,
+	serviceName string,
+	afterSequence uint64,
+	limit int32,
+) (*observerv1.GetRequestLogsResponse, *Route, error) {
+	metaResp, route, err := callMeta(ctx, s.router, serviceName,
+		metaapiconnect.PolymorphMetaServiceClient.GetRequestLogs,
+		func(path []string) *metav1.GetRequestLogsRequest {
+			return &metav1.GetRequestLogsRequest{
+				ServiceName:   serviceName,
+				AfterSequence: afterSequence,
+				Limit:         limit,
+				Path:          path,
+			}
+		})
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal,
-			fmt.Errorf("failed to read response body: %w", err))
-	}
+		return nil, route, err
+	}
+
+	logs := make([]*observerv1.RequestLog, 0, len(metaResp.Logs))
+	for _, entry := range metaResp.Logs {
+		logs = append(logs, &observerv1.RequestLog{
+			Sequence:   entry.Sequence,
+			Timestamp:  entry.Timestamp,
+			Method:     entry.Method,
+			Path:       entry.Path,
+			Status:     entry.Status,
+			DurationMs: entry.DurationMs,
+			Level:      entry.Level,
+		})
+	}
+
+	return &observerv1.GetRequestLogsResponse{
+		Logs:           logs,
+		LatestSequence: metaResp.LatestSequence,
+	}, route, nil
+}
 
-	resp := &observerv1.GetRequestLogsResponse{}
-	if err := protojson.Unmarshal(body, resp); err != nil {
-		return nil, connect.NewError(connect.CodeInternal,
-			fmt.Errorf("failed to parse Polymorph response: %w", err))
+// findService returns the first service with the given name, or nil
+func findService(topology *observerv1.Topology, name string) *observerv1.Service {
+	for _, svc := range topology.Services {
+		if svc.Name == name {
+			return svc
+		}
 	}
-
-	return connect.NewResponse(resp), nil
+	return nil
 }
 
 // ServiceInfo represents service metadata from Polymorph
@@ -358,8 +391,11 @@ type ServiceInfo struct {
 func (s *ObserverService) buildTopology() *observerv1.Topology {
 	members := s.mesh.Members()
 	services := make([]*observerv1.Service, 0)
+	now := time.Now()
 
 	for _, member := range members {
+		rttMs := s.estimateRTTMs(member.Name)
+
 		// Check if member has JSON-encoded services (new format)
 		if servicesJSON, ok := member.Tags["services"]; ok {
 			// Parse JSON array of services
@@ -371,27 +407,35 @@ func (s *ObserverService) buildTopology() *observerv1.Topology {
 
 			// Create a Service entry for each service in this node
 			for _, info := range serviceInfos {
+				status, reason := s.serviceStatus(member, info.Name, info.Address, now)
 				service := &observerv1.Service{
-					Name:      info.Name,
-					Type:      info.Type,
-					Address:   info.Address,
-					NodeName:  member.Name,
-					Upstreams: info.Upstreams,
-					Status:    mapStatus(member.Status),
-					Tags:      member.Tags,
+					Name:         info.Name,
+					Type:         info.Type,
+					Address:      info.Address,
+					NodeName:     member.Name,
+					Upstreams:    info.Upstreams,
+					Status:       status,
+					StatusReason: reason,
+					Tags:         member.Tags,
+					RttMs:        rttMs,
+					Metrics:      s.metricsSummary(info.Name, now),
 					// Resources are fetched via RPC on-demand
 				}
 				services = append(services, service)
 			}
 		} else if member.Tags["service_type"] != "" {
 			// Fallback to old format for backwards compatibility
+			status, reason := s.serviceStatus(member, member.Tags["service_name"], member.Addr, now)
 			service := &observerv1.Service{
-				Name:     member.Tags["service_name"],
-				Type:     member.Tags["service_type"],
-				Address:  member.Addr,
-				NodeName: member.Name,
-				Status:   mapStatus(member.Status),
-				Tags:     member.Tags,
+				Name:         member.Tags["service_name"],
+				Type:         member.Tags["service_type"],
+				Address:      member.Addr,
+				NodeName:     member.Name,
+				Status:       status,
+				StatusReason: reason,
+				Tags:         member.Tags,
+				RttMs:        rttMs,
+				Metrics:      s.metricsSummary(member.Tags["service_name"], now),
 			}
 			services = append(services, service)
 		}
@@ -400,34 +444,94 @@ func (s *ObserverService) buildTopology() *observerv1.Topology {
 
 	return &observerv1.Topology{
 		Services:  services,
-		Timestamp: time.Now().UnixMilli(),
+		Timestamp: now.UnixMilli(),
 	}
 }
 
-// notifyWatchers sends topology updates to all watchers
+// scheduleNotify publishes the topology once mesh events have been quiet for
+// notifyDebounce, or at most notifyMaxDelay after the first pending event
+func (s *ObserverService) scheduleNotify() {
+	s.notifyMu.Lock()
+	defer s.notifyMu.Unlock()
+
+	now := time.Now()
+
+	// Extend the wait if a notification is still pending
+	if s.notifyTimer != nil && s.notifyTimer.Stop() {
+		delay := min(notifyDebounce, notifyMaxDelay-now.Sub(s.notifyPending))
+		s.notifyTimer.Reset(max(delay, 0))
+		return
+	}
+
+	s.notifyPending = now
+	s.notifyTimer = time.AfterFunc(notifyDebounce, s.notifyWatchers)
+}
+
+// estimateRTTMs returns the estimated RTT from Lattice to a node in
+// milliseconds, or nil if it is not known
+func (s *ObserverService) estimateRTTMs(node string) *float64 {
+	rtt, ok := s.mesh.EstimateRTT(s.mesh.NodeName(), node)
+	if !ok {
+		return nil
+	}
+	ms := float64(rtt) / float64(time.Millisecond)
+	return &ms
+}
+
+// notifyWatchers publishes the current topology to all watchers
 func (s *ObserverService) notifyWatchers() {
-	s.mu.RLock()
-	defer s.mu.RUnlock()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.publishLocked(s.buildTopology())
+}
 
-	if len(s.watchers) == 0 {
+// publishLocked records topology as a new revision if it differs from the
+// last published topology, and sends it to watchers as a full snapshot or a
+// delta. s.mu must be held for writing.
+func (s *ObserverService) publishLocked(topology *observerv1.Topology) {
+	delta := diffTopology(s.current, topology)
+	if s.current != nil && isEmptyDelta(delta) {
 		return
 	}
 
-	topology := s.buildTopology()
-	update := &observerv1.TopologyUpdate{
-		Topology:   topology,
-		UpdateType: observerv1.UpdateType_UPDATE_TYPE_FULL,
+	delta.BaseRevision = s.revision
+	s.revision++
+	s.current = topology
+	s.recordLocked(delta)
+
+	full := s.fullUpdateLocked()
+	incremental := &observerv1.TopologyUpdate{
+		UpdateType: observerv1.UpdateType_UPDATE_TYPE_INCREMENTAL,
+		Revision:   s.revision,
+		Delta:      delta,
 	}
 
-	for ch := range s.watchers {
+	for w := range s.watchers {
+		update := full
+		if w.incremental {
+			update = incremental
+		}
+
 		select {
-		case ch <- update:
+		case w.ch <- update:
 		default:
-			// Skip if channel is full
+			// Watcher is behind, it will resync with a full snapshot
+			w.resync.Store(true)
 		}
 	}
 }
 
+// fullUpdateLocked returns a full snapshot of the last published topology.
+// s.mu must be held.
+func (s *ObserverService) fullUpdateLocked() *observerv1.TopologyUpdate {
+	return &observerv1.TopologyUpdate{
+		Topology:   s.current,
+		UpdateType: observerv1.UpdateType_UPDATE_TYPE_FULL,
+		Revision:   s.revision,
+	}
+}
+
 // mapStatus maps Serf status to ServiceStatus
 func mapStatus(status string) observerv1.ServiceStatus {
 	switch status {
@@ -2,11 +2,14 @@ package api
 
 import (
 	"context"
+	"fmt"
+	"net/http/httptest"
 	"testing"
 	"time"
 
 	"connectrpc.com/connect"
 	observerv1 "github.com/jumppad-labs/lattice/pkg/api/observer/v1"
+	"github.com/jumppad-labs/lattice/pkg/api/observer/v1/observerapiconnect"
 	latticeserf "github.com/jumppad-labs/lattice/internal/serf"
 	"github.com/stretchr/testify/require"
 )
@@ -44,6 +47,14 @@ func TestObserverService_GetTopology(t *testing.T) {
 	require.NotNil(t, resp)
 	require.NotNil(t, resp.Msg.Topology)
 	require.NotNil(t, resp.Msg.Topology.Services)
+	require.Empty(t, resp.Msg.Graph)
+
+	require.NoError(t, mesh.Graph().Update([]byte(`{"n":"a","nb":["test-lattice"]}`)))
+	resp, err = svc.GetTopology(ctx, connect.NewRequest(&observerv1.GetTopologyRequest{IncludeGraph: true}))
+	require.NoError(t, err)
+	require.Len(t, resp.Msg.Graph, 2)
+	require.Equal(t, "a", resp.Msg.Graph[0].Node)
+	require.Equal(t, []string{"test-lattice"}, resp.Msg.Graph[0].Neighbors)
 }
 
 func TestObserverService_BuildTopology(t *testing.T) {
@@ -115,3 +126,155 @@ func TestMapStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestObserverService_PublishIncremental(t *testing.T) {
+	svc := &ObserverService{
+		watchers: make(map[*topologyWatcher]struct{}),
+	}
+
+	full := &topologyWatcher{ch: make(chan *observerv1.TopologyUpdate, 10)}
+	incremental := &topologyWatcher{ch: make(chan *observerv1.TopologyUpdate, 10), incremental: true}
+	svc.watchers[full] = struct{}{}
+	svc.watchers[incremental] = struct{}{}
+
+	svc.mu.Lock()
+	svc.publishLocked(&observerv1.Topology{
+		Services: []*observerv1.Service{testService("node1", "api")},
+	})
+	svc.publishLocked(&observerv1.Topology{
+		Services: []*observerv1.Service{testService("node1", "api"), testService("node2", "users")},
+	})
+
+	// Unchanged topology doesn't create a revision
+	svc.publishLocked(&observerv1.Topology{
+		Services: []*observerv1.Service{testService("node1", "api"), testService("node2", "users")},
+	})
+	svc.mu.Unlock()
+
+	require.Equal(t, uint64(2), svc.revision)
+	require.Len(t, full.ch, 2)
+	require.Len(t, incremental.ch, 2)
+
+	<-full.ch
+	update := <-full.ch
+	require.Equal(t, observerv1.UpdateType_UPDATE_TYPE_FULL, update.UpdateType)
+	require.Equal(t, uint64(2), update.Revision)
+	require.Len(t, update.Topology.Services, 2)
+
+	<-incremental.ch
+	update = <-incremental.ch
+	require.Equal(t, observerv1.UpdateType_UPDATE_TYPE_INCREMENTAL, update.UpdateType)
+	require.Equal(t, uint64(2), update.Revision)
+	require.Equal(t, uint64(1), update.Delta.BaseRevision)
+	require.Len(t, update.Delta.Added, 1)
+	require.Equal(t, "users", update.Delta.Added[0].Name)
+	require.Nil(t, update.Topology)
+}
+
+func TestObserverService_PublishSlowWatcher(t *testing.T) {
+	svc := &ObserverService{
+		watchers: make(map[*topologyWatcher]struct{}),
+	}
+
+	w := &topologyWatcher{ch: make(chan *observerv1.TopologyUpdate, 1), incremental: true}
+	svc.watchers[w] = struct{}{}
+
+	svc.mu.Lock()
+	svc.publishLocked(&observerv1.Topology{
+		Services: []*observerv1.Service{testService("node1", "api")},
+	})
+	svc.publishLocked(&observerv1.Topology{
+		Services: []*observerv1.Service{testService("node2", "users")},
+	})
+	svc.mu.Unlock()
+
+	// The second update was dropped, so the watcher must resync
+	require.Len(t, w.ch, 1)
+	require.True(t, w.resync.Load())
+}
+
+func TestObserverService_WatchTopologyIncremental(t *testing.T) {
+	mesh1, err := latticeserf.NewMesh(latticeserf.MeshConfig{
+		NodeName: "test-lattice",
+		BindAddr: "127.0.0.1",
+		BindPort: 0,
+	})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	require.NoError(t, mesh1.Start(ctx))
+	defer mesh1.Stop()
+
+	svc := NewObserverService(mesh1)
+	svc.Start()
+	_, handler := observerapiconnect.NewObserverServiceHandler(svc)
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	client := observerapiconnect.NewObserverServiceClient(server.Client(), server.URL)
+	stream, err := client.WatchTopology(ctx, connect.NewRequest(&observerv1.WatchTopologyRequest{
+		Incremental: true,
+	}))
+	require.NoError(t, err)
+	defer stream.Close()
+
+	// Initial snapshot
+	require.True(t, stream.Receive())
+	initial := stream.Msg()
+	require.Equal(t, observerv1.UpdateType_UPDATE_TYPE_FULL, initial.UpdateType)
+	require.Empty(t, initial.Topology.Services)
+
+	// A Polymorph node joins with a service
+	mesh2, err := latticeserf.NewMesh(latticeserf.MeshConfig{
+		NodeName:  "polymorph-1",
+		BindAddr:  "127.0.0.1",
+		BindPort:  0,
+		JoinAddrs: []string{fmt.Sprintf("127.0.0.1:%d", mesh1.Members()[0].Port)},
+		Tags: map[string]string{
+			"services": `[{"name":"users","type":"http","address":"127.0.0.1:8080"}]`,
+		},
+	})
+	require.NoError(t, err)
+	require.NoError(t, mesh2.Start(ctx))
+	defer mesh2.Stop()
+
+	require.True(t, stream.Receive())
+	update := stream.Msg()
+	require.Equal(t, observerv1.UpdateType_UPDATE_TYPE_INCREMENTAL, update.UpdateType)
+	require.Greater(t, update.Revision, initial.Revision)
+	require.Equal(t, initial.Revision, update.Delta.BaseRevision)
+	require.Len(t, update.Delta.Added, 1)
+	require.Equal(t, "users", update.Delta.Added[0].Name)
+	require.Equal(t, "polymorph-1", update.Delta.Added[0].NodeName)
+}
+
+func TestObserverService_ScheduleNotifyCoalesces(t *testing.T) {
+	mesh, err := latticeserf.NewMesh(latticeserf.MeshConfig{
+		NodeName: "test-lattice",
+	})
+	require.NoError(t, err)
+
+	svc := NewObserverService(mesh)
+
+	w := &topologyWatcher{ch: make(chan *observerv1.TopologyUpdate, 10)}
+	svc.mu.Lock()
+	svc.watchers[w] = struct{}{}
+	svc.mu.Unlock()
+
+	for i := 0; i < 5; i++ {
+		svc.scheduleNotify()
+	}
+
+	select {
+	case update := <-w.ch:
+		require.Equal(t, uint64(1), update.Revision)
+	case <-time.After(time.Second):
+		t.Fatal("timeout waiting for update")
+	}
+
+	// The burst produced a single update
+	time.Sleep(2 * notifyDebounce)
+	require.Empty(t, w.ch)
+}
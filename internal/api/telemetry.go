@@ -0,0 +1,134 @@
+package api
+
+import (
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/serf/serf"
+	"github.com/jumppad-labs/lattice/internal/metrics"
+	"github.com/jumppad-labs/lattice/internal/telemetry"
+)
+
+// memberStatuses are reported even when no member has them, so dashboards
+// see zero rather than a missing series
+var memberStatuses = []string{
+	serf.StatusAlive.String(),
+	serf.StatusLeaving.String(),
+	serf.StatusLeft.String(),
+	serf.StatusFailed.String(),
+}
+
+// Collect writes Lattice internals and, if metrics are enabled, the request
+// metrics of every service for a Prometheus scrape
+func (s *ObserverService) Collect(w *telemetry.Writer) {
+	counts := make(map[string]int)
+	for _, member := range s.mesh.Members() {
+		counts[member.Status]++
+	}
+	w.Family("lattice_members", telemetry.TypeGauge, "Serf members by status.")
+	for _, status := range memberStatuses {
+		w.Sample("lattice_members", float64(counts[status]), "status", status)
+	}
+
+	events := s.mesh.EventStats()
+	w.Family("lattice_gossip_events_total", telemetry.TypeCounter, "Serf events processed, by event type.")
+	types := make([]string, 0, len(events.Processed))
+	for typ := range events.Processed {
+		types = append(types, typ)
+	}
+	slices.Sort(types)
+	for _, typ := range types {
+		w.Sample("lattice_gossip_events_total", float64(events.Processed[typ]), "type", typ)
+	}
+	w.Family("lattice_event_queue_depth", telemetry.TypeGauge, "Serf events waiting to be processed.")
+	w.Sample("lattice_event_queue_depth", float64(events.Queued))
+	w.Family("lattice_event_queue_capacity", telemetry.TypeGauge, "Capacity of the Serf event queue.")
+	w.Sample("lattice_event_queue_capacity", float64(events.Capacity))
+
+	s.mu.RLock()
+	topologyWatchers := len(s.watchers)
+	s.mu.RUnlock()
+	w.Family("lattice_watchers", telemetry.TypeGauge, "Open streaming RPCs, by stream.")
+	w.Sample("lattice_watchers", float64(topologyWatchers), "stream", "topology")
+	w.Sample("lattice_watchers", float64(s.logs.watchers()), "stream", "request_logs")
+
+	nodes, edges := s.mesh.Graph().Size()
+	w.Family("lattice_graph_nodes", telemetry.TypeGauge, "Nodes in the mesh connectivity graph.")
+	w.Sample("lattice_graph_nodes", float64(nodes))
+	w.Family("lattice_graph_edges", telemetry.TypeGauge, "Links in the mesh connectivity graph.")
+	w.Sample("lattice_graph_edges", float64(edges))
+
+	s.router.Collect(w)
+
+	if s.metrics != nil {
+		s.collectServiceMetrics(w)
+	}
+}
+
+// collectServiceMetrics writes the request metrics of every service
+func (s *ObserverService) collectServiceMetrics(w *telemetry.Writer) {
+	now := time.Now()
+
+	type sample struct {
+		service string
+		window  metrics.Window
+	}
+	var samples []sample
+	for _, name := range s.metrics.Services() {
+		m, _ := s.metrics.Service(name, false, now)
+		for _, window := range m.Windows {
+			samples = append(samples, sample{service: name, window: window})
+		}
+	}
+
+	families := []struct {
+		name  string
+		help  string
+		value func(metrics.Window) float64
+	}{
+		{"lattice_service_requests", "Requests to a service within the window.",
+			func(m metrics.Window) float64 { return float64(m.Requests) }},
+		{"lattice_service_errors", "Requests to a service with a 5xx status within the window.",
+			func(m metrics.Window) float64 { return float64(m.Errors) }},
+		{"lattice_service_request_rate", "Requests per second to a service over the window.",
+			func(m metrics.Window) float64 { return m.Rate }},
+		{"lattice_service_error_ratio", "Fraction of requests to a service that failed over the window.",
+			func(m metrics.Window) float64 { return m.ErrorRate }},
+	}
+	for _, f := range families {
+		w.Family(f.name, telemetry.TypeGauge, f.help)
+		for _, sample := range samples {
+			w.Sample(f.name, f.value(sample.window),
+				"service", sample.service, "window", windowLabel(sample.window.Duration))
+		}
+	}
+
+	w.Family("lattice_service_latency_seconds", telemetry.TypeGauge, "Request latency percentiles of a service over the window.")
+	for _, sample := range samples {
+		window := windowLabel(sample.window.Duration)
+		for _, q := range []struct {
+			quantile string
+			value    time.Duration
+		}{
+			{"0.5", sample.window.P50},
+			{"0.95", sample.window.P95},
+			{"0.99", sample.window.P99},
+		} {
+			w.Sample("lattice_service_latency_seconds", q.value.Seconds(),
+				"service", sample.service, "window", window, "quantile", q.quantile)
+		}
+	}
+}
+
+// windowLabel formats a window compactly, e.g. "5m" rather than "5m0s"
+func windowLabel(d time.Duration) string {
+	switch {
+	case d%time.Hour == 0:
+		return strconv.FormatInt(int64(d/time.Hour), 10) + "h"
+	case d%time.Minute == 0:
+		return strconv.FormatInt(int64(d/time.Minute), 10) + "m"
+	default:
+		return strconv.FormatInt(int64(d/time.Second), 10) + "s"
+	}
+}
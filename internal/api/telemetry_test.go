@@ -0,0 +1,64 @@
+package api
+
+import (
+	"io"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/jumppad-labs/lattice/internal/metrics"
+	latticeserf "github.com/jumppad-labs/lattice/internal/serf"
+	"github.com/jumppad-labs/lattice/internal/telemetry"
+	"github.com/stretchr/testify/require"
+)
+
+func TestObserverService_Collect(t *testing.T) {
+	mesh, err := latticeserf.NewMesh(latticeserf.MeshConfig{
+		NodeName: "test-lattice",
+	})
+	require.NoError(t, err)
+	require.NoError(t, mesh.Graph().Update([]byte(`{"n":"a","nb":["test-lattice","b"]}`)))
+
+	svc := NewObserverService(mesh)
+
+	scrape := func() string {
+		rec := httptest.NewRecorder()
+		telemetry.Handler(svc).ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+		body, err := io.ReadAll(rec.Body)
+		require.NoError(t, err)
+		return string(body)
+	}
+
+	body := scrape()
+	require.Contains(t, body, `lattice_members{status="alive"} 0`)
+	require.Contains(t, body, `lattice_members{status="failed"} 0`)
+	require.Contains(t, body, "lattice_event_queue_capacity 256\n")
+	require.Contains(t, body, `lattice_watchers{stream="topology"} 0`)
+	require.Contains(t, body, "lattice_graph_nodes 3\n")
+	require.Contains(t, body, "lattice_graph_edges 2\n")
+	require.Contains(t, body, "# TYPE lattice_proxy_requests_total counter")
+	require.NotContains(t, body, "lattice_service_requests")
+
+	recorder, err := metrics.NewRecorder(metrics.Options{Windows: []time.Duration{time.Minute}})
+	require.NoError(t, err)
+	svc.SetMetrics(recorder, time.Minute)
+
+	now := time.Now()
+	recorder.Record("api", []metrics.Sample{
+		{Method: "GET", Path: "/", Status: 200, Duration: 10 * time.Millisecond, Time: now},
+		{Method: "GET", Path: "/", Status: 500, Duration: 10 * time.Millisecond, Time: now},
+	}, now)
+
+	body = scrape()
+	require.Contains(t, body, `lattice_service_requests{service="api",window="1m"} 2`)
+	require.Contains(t, body, `lattice_service_errors{service="api",window="1m"} 1`)
+	require.Contains(t, body, `lattice_service_error_ratio{service="api",window="1m"} 0.5`)
+	require.Contains(t, body, `lattice_service_latency_seconds{service="api",window="1m",quantile="0.99"}`)
+}
+
+func TestWindowLabel(t *testing.T) {
+	require.Equal(t, "30s", windowLabel(30*time.Second))
+	require.Equal(t, "5m", windowLabel(5*time.Minute))
+	require.Equal(t, "90s", windowLabel(90*time.Second))
+	require.Equal(t, "1h", windowLabel(time.Hour))
+}
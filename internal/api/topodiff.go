@@ -0,0 +1,190 @@
+package api
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jumppad-labs/lattice/internal/history"
+	observerv1 "github.com/jumppad-labs/lattice/pkg/api/observer/v1"
+)
+
+// servicesTag is the member tag holding a node's service definitions. Its
+// contents already show up as service fields, so it is left out of tag diffs.
+const servicesTag = "services"
+
+// DiffTopology compares two topologies, each the live topology, a recorded
+// revision or a snapshot supplied by the caller
+func (s *ObserverService) DiffTopology(
+	ctx context.Context,
+	req *connect.Request[observerv1.DiffTopologyRequest],
+) (*connect.Response[observerv1.DiffTopologyResponse], error) {
+	from, fromRev, err := s.resolveTopology(req.Msg.From)
+	if err != nil {
+		return nil, err
+	}
+	to, toRev, err := s.resolveTopology(req.Msg.To)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := compareTopologies(from, to)
+	resp.FromRevision = fromRev
+	resp.FromTimestamp = from.GetTimestamp()
+	resp.ToRevision = toRev
+	resp.ToTimestamp = to.GetTimestamp()
+
+	return connect.NewResponse(resp), nil
+}
+
+// resolveTopology returns the topology a ref selects and its revision, which
+// is zero for the live topology and snapshots
+func (s *ObserverService) resolveTopology(ref *observerv1.TopologyRef) (*observerv1.Topology, uint64, error) {
+	var (
+		rev *history.Revision
+		err error
+	)
+
+	switch r := ref.GetRef().(type) {
+	case nil:
+		topology := s.buildTopology()
+		topology.Timestamp = time.Now().UnixMilli()
+		return topology, 0, nil
+	case *observerv1.TopologyRef_Snapshot:
+		return r.Snapshot, 0, nil
+	case *observerv1.TopologyRef_Revision:
+		if s.history == nil {
+			return nil, 0, errHistoryDisabled()
+		}
+		rev, err = s.history.Get(r.Revision)
+	case *observerv1.TopologyRef_Timestamp:
+		if s.history == nil {
+			return nil, 0, errHistoryDisabled()
+		}
+		rev, err = s.history.At(r.Timestamp)
+	}
+
+	if errors.Is(err, history.ErrNotFound) {
+		return nil, 0, connect.NewError(connect.CodeNotFound, err)
+	} else if err != nil {
+		return nil, 0, connect.NewError(connect.CodeInternal, err)
+	}
+	return rev.Topology, rev.Revision, nil
+}
+
+// compareTopologies lists the services added, removed and changed between
+// two topologies. Unlike diffTopology it reports the before and after value
+// of each change, for people rather than watchers. Results are sorted by
+// service name, then node.
+func compareTopologies(from, to *observerv1.Topology) *observerv1.DiffTopologyResponse {
+	resp := &observerv1.DiffTopologyResponse{}
+
+	fromServices := make(map[string]*observerv1.Service, len(from.GetServices()))
+	for _, svc := range from.GetServices() {
+		fromServices[serviceKey(svc)] = svc
+	}
+
+	seen := make(map[string]bool, len(to.GetServices()))
+	for _, svc := range to.GetServices() {
+		key := serviceKey(svc)
+		seen[key] = true
+
+		old, ok := fromServices[key]
+		if !ok {
+			resp.Added = append(resp.Added, svc)
+			continue
+		}
+
+		if d := diffService(old, svc); d != nil {
+			resp.Changed = append(resp.Changed, d)
+		}
+	}
+
+	for _, svc := range from.GetServices() {
+		if !seen[serviceKey(svc)] {
+			resp.Removed = append(resp.Removed, svc)
+		}
+	}
+
+	byService := func(a, b *observerv1.Service) int {
+		return cmp.Or(strings.Compare(a.Name, b.Name), strings.Compare(a.NodeName, b.NodeName))
+	}
+	slices.SortFunc(resp.Added, byService)
+	slices.SortFunc(resp.Removed, byService)
+	slices.SortFunc(resp.Changed, func(a, b *observerv1.ServiceDiff) int {
+		return cmp.Or(strings.Compare(a.Name, b.Name), strings.Compare(a.NodeName, b.NodeName))
+	})
+
+	return resp
+}
+
+// diffService returns the differences between two versions of a service, or
+// nil if there are none
+func diffService(a, b *observerv1.Service) *observerv1.ServiceDiff {
+	d := &observerv1.ServiceDiff{
+		Name:     b.Name,
+		NodeName: b.NodeName,
+	}
+	changed := false
+
+	if a.Type != b.Type {
+		d.Type = &observerv1.ValueChange{Before: a.Type, After: b.Type}
+		changed = true
+	}
+	if a.Address != b.Address {
+		d.Address = &observerv1.ValueChange{Before: a.Address, After: b.Address}
+		changed = true
+	}
+	if a.Status != b.Status {
+		d.Status = &observerv1.ValueChange{Before: a.Status.String(), After: b.Status.String()}
+		changed = true
+	}
+
+	for _, up := range b.Upstreams {
+		if !slices.Contains(a.Upstreams, up) {
+			d.UpstreamsAdded = append(d.UpstreamsAdded, up)
+			changed = true
+		}
+	}
+	for _, up := range a.Upstreams {
+		if !slices.Contains(b.Upstreams, up) {
+			d.UpstreamsRemoved = append(d.UpstreamsRemoved, up)
+			changed = true
+		}
+	}
+	slices.Sort(d.UpstreamsAdded)
+	slices.Sort(d.UpstreamsRemoved)
+
+	for key, after := range b.Tags {
+		if key == servicesTag {
+			continue
+		}
+		before, ok := a.Tags[key]
+		switch {
+		case !ok:
+			d.Tags = append(d.Tags, &observerv1.TagChange{Key: key, After: after, Added: true})
+		case before != after:
+			d.Tags = append(d.Tags, &observerv1.TagChange{Key: key, Before: before, After: after})
+		}
+	}
+	for key, before := range a.Tags {
+		if _, ok := b.Tags[key]; !ok && key != servicesTag {
+			d.Tags = append(d.Tags, &observerv1.TagChange{Key: key, Before: before, Removed: true})
+		}
+	}
+	slices.SortFunc(d.Tags, func(x, y *observerv1.TagChange) int {
+		return strings.Compare(x.Key, y.Key)
+	})
+	if len(d.Tags) > 0 {
+		changed = true
+	}
+
+	if !changed {
+		return nil
+	}
+	return d
+}
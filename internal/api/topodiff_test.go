@@ -0,0 +1,108 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/jumppad-labs/lattice/internal/history"
+	latticeserf "github.com/jumppad-labs/lattice/internal/serf"
+	observerv1 "github.com/jumppad-labs/lattice/pkg/api/observer/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompareTopologies(t *testing.T) {
+	from := &observerv1.Topology{Services: []*observerv1.Service{
+		{Name: "api", NodeName: "node1", Type: "http", Address: ":8080", Upstreams: []string{"db", "cache"},
+			Status: observerv1.ServiceStatus_SERVICE_STATUS_HEALTHY, Tags: map[string]string{"version": "1", "services": "[]", "zone": "a"}},
+		{Name: "db", NodeName: "node1", Type: "tcp"},
+		{Name: "old", NodeName: "node2"},
+	}}
+	to := &observerv1.Topology{Services: []*observerv1.Service{
+		{Name: "new", NodeName: "node2"},
+		{Name: "api", NodeName: "node1", Type: "http", Address: ":9090", Upstreams: []string{"db", "queue"},
+			Status: observerv1.ServiceStatus_SERVICE_STATUS_DEGRADED, Tags: map[string]string{"version": "2", "services": "[{}]", "team": "x"}},
+		{Name: "db", NodeName: "node1", Type: "tcp"},
+	}}
+
+	resp := compareTopologies(from, to)
+	require.Len(t, resp.Added, 1)
+	require.Equal(t, "new", resp.Added[0].Name)
+	require.Len(t, resp.Removed, 1)
+	require.Equal(t, "old", resp.Removed[0].Name)
+
+	require.Len(t, resp.Changed, 1)
+	d := resp.Changed[0]
+	require.Equal(t, "api", d.Name)
+	require.Nil(t, d.Type)
+	require.Equal(t, ":8080", d.Address.Before)
+	require.Equal(t, ":9090", d.Address.After)
+	require.Equal(t, "SERVICE_STATUS_HEALTHY", d.Status.Before)
+	require.Equal(t, "SERVICE_STATUS_DEGRADED", d.Status.After)
+	require.Equal(t, []string{"queue"}, d.UpstreamsAdded)
+	require.Equal(t, []string{"cache"}, d.UpstreamsRemoved)
+
+	// The services tag is left out, the rest are sorted by key
+	require.Len(t, d.Tags, 3)
+	require.Equal(t, "team", d.Tags[0].Key)
+	require.True(t, d.Tags[0].Added)
+	require.Equal(t, "version", d.Tags[1].Key)
+	require.Equal(t, "1", d.Tags[1].Before)
+	require.Equal(t, "2", d.Tags[1].After)
+	require.Equal(t, "zone", d.Tags[2].Key)
+	require.True(t, d.Tags[2].Removed)
+
+	require.Empty(t, compareTopologies(to, to).Changed)
+}
+
+func TestObserverService_DiffTopology(t *testing.T) {
+	mesh, err := latticeserf.NewMesh(latticeserf.MeshConfig{
+		NodeName: "test-lattice",
+	})
+	require.NoError(t, err)
+	svc := NewObserverService(mesh)
+
+	revisionRef := func(rev uint64) *observerv1.TopologyRef {
+		return &observerv1.TopologyRef{Ref: &observerv1.TopologyRef_Revision{Revision: rev}}
+	}
+
+	// Revisions need history, snapshots don't
+	_, err = svc.DiffTopology(context.Background(), connect.NewRequest(&observerv1.DiffTopologyRequest{
+		From: revisionRef(1),
+	}))
+	require.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+
+	snapshot := &observerv1.Topology{Timestamp: 1000, Services: []*observerv1.Service{{Name: "api", NodeName: "node1"}}}
+	resp, err := svc.DiffTopology(context.Background(), connect.NewRequest(&observerv1.DiffTopologyRequest{
+		From: &observerv1.TopologyRef{Ref: &observerv1.TopologyRef_Snapshot{Snapshot: snapshot}},
+	}))
+	require.NoError(t, err)
+	require.Equal(t, int64(1000), resp.Msg.FromTimestamp)
+	require.NotZero(t, resp.Msg.ToTimestamp)
+	require.Len(t, resp.Msg.Removed, 1)
+
+	store, err := history.Open(history.Options{Dir: t.TempDir(), MaxBytes: 1 << 20})
+	require.NoError(t, err)
+	defer store.Close()
+	require.NoError(t, svc.SetHistory(store))
+
+	svc.mu.Lock()
+	svc.publishLocked(&observerv1.Topology{Timestamp: 1000, Services: []*observerv1.Service{{Name: "api", NodeName: "node1"}}})
+	svc.publishLocked(&observerv1.Topology{Timestamp: 2000, Services: []*observerv1.Service{{Name: "api", NodeName: "node1"}, {Name: "db", NodeName: "node1"}}})
+	svc.mu.Unlock()
+
+	resp, err = svc.DiffTopology(context.Background(), connect.NewRequest(&observerv1.DiffTopologyRequest{
+		From: revisionRef(1),
+		To:   &observerv1.TopologyRef{Ref: &observerv1.TopologyRef_Timestamp{Timestamp: 2500}},
+	}))
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), resp.Msg.FromRevision)
+	require.Equal(t, uint64(2), resp.Msg.ToRevision)
+	require.Len(t, resp.Msg.Added, 1)
+	require.Equal(t, "db", resp.Msg.Added[0].Name)
+
+	_, err = svc.DiffTopology(context.Background(), connect.NewRequest(&observerv1.DiffTopologyRequest{
+		From: revisionRef(9),
+	}))
+	require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+}
@@ -0,0 +1,72 @@
+package cli
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	observerv1 "github.com/jumppad-labs/lattice/pkg/api/observer/v1"
+	"github.com/jumppad-labs/lattice/pkg/api/observer/v1/observerapiconnect"
+)
+
+// defaultServerAddr is the address of the Lattice API used by client commands
+const defaultServerAddr = "http://127.0.0.1:9000"
+
+// serverAddrEnv overrides defaultServerAddr
+const serverAddrEnv = "LATTICE_ADDR"
+
+// serverAddr is the address of the Lattice API, set by the global --address
+// flag
+var serverAddr string
+
+func init() {
+	addr := os.Getenv(serverAddrEnv)
+	if addr == "" {
+		addr = defaultServerAddr
+	}
+	rootCmd.PersistentFlags().StringVar(&serverAddr, "address", addr,
+		"address of the Lattice server API used by client commands (env "+serverAddrEnv+")")
+}
+
+// serverBaseURL returns the base URL of the Lattice API, adding a scheme if
+// the address doesn't include one
+func serverBaseURL(addr string) string {
+	if !strings.HasPrefix(addr, "http://") && !strings.HasPrefix(addr, "https://") {
+		addr = "http://" + addr
+	}
+	return strings.TrimRight(addr, "/")
+}
+
+// httpClient is used by client commands to call the Lattice API
+var httpClient = &http.Client{}
+
+// newObserverClient creates a client for the server's Observer API
+func newObserverClient() observerapiconnect.ObserverServiceClient {
+	return observerapiconnect.NewObserverServiceClient(httpClient, serverBaseURL(serverAddr))
+}
+
+// parseTime parses an RFC 3339 timestamp, or a duration meaning that long
+// before now (e.g. "20m")
+func parseTime(value string, now time.Time) (time.Time, error) {
+	if d, err := time.ParseDuration(value); err == nil {
+		return now.Add(-d), nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid time %q: use RFC 3339 (e.g. 2024-01-02T15:04:05Z) or a duration ago (e.g. 20m)", value)
+	}
+	return t, nil
+}
+
+// formatTime formats a Unix millisecond timestamp in local time
+func formatTime(ms int64) string {
+	return time.UnixMilli(ms).Format("2006-01-02 15:04:05")
+}
+
+// statusName returns a service status without its enum prefix
+func statusName(status observerv1.ServiceStatus) string {
+	return strings.TrimPrefix(status.String(), "SERVICE_STATUS_")
+}
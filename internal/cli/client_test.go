@@ -0,0 +1,52 @@
+package cli
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestServerBaseURL(t *testing.T) {
+	tests := []struct {
+		addr string
+		want string
+	}{
+		{"127.0.0.1:9000", "http://127.0.0.1:9000"},
+		{"lattice.internal:9000/", "http://lattice.internal:9000"},
+		{"http://127.0.0.1:9000", "http://127.0.0.1:9000"},
+		{"https://lattice.example.com/", "https://lattice.example.com"},
+		{"https://lattice.example.com/api//", "https://lattice.example.com/api"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.addr, func(t *testing.T) {
+			require.Equal(t, tt.want, serverBaseURL(tt.addr))
+		})
+	}
+}
+
+func TestParseTime(t *testing.T) {
+	now := time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC)
+
+	got, err := parseTime("20m", now)
+	require.NoError(t, err)
+	require.Equal(t, now.Add(-20*time.Minute), got)
+
+	got, err = parseTime("1h30m", now)
+	require.NoError(t, err)
+	require.Equal(t, now.Add(-90*time.Minute), got)
+
+	got, err = parseTime("2024-01-01T10:00:00Z", now)
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC), got)
+
+	got, err = parseTime("2024-01-01T10:00:00+02:00", now)
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2024, 1, 1, 8, 0, 0, 0, time.UTC), got.UTC())
+
+	for _, value := range []string{"", "yesterday", "2024-01-01", "20"} {
+		_, err := parseTime(value, now)
+		require.Error(t, err, value)
+	}
+}
@@ -0,0 +1,242 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	observerv1 "github.com/jumppad-labs/lattice/pkg/api/observer/v1"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+var diffCmd = &cobra.Command{
+	Use:   "diff",
+	Short: "Compare two versions of the topology",
+	Long: `Compare two versions of the topology and list the services added, removed and
+changed between them.
+
+Each side is "live", a revision number, a time, or a snapshot file. Times are
+RFC 3339 timestamps or durations before now, e.g. "20m". Revisions and times
+need a topology_history block in the server config.
+
+Snapshot files hold a topology as JSON, e.g. as saved with:
+  curl -s -X POST -H 'Content-Type: application/json' -d '{}' \
+    http://127.0.0.1:9000/observer.v1.ObserverService/GetTopology > before.json
+
+Examples:
+  # What changed in the last hour
+  lattice diff --from 1h
+
+  # Between two revisions
+  lattice diff --from 12 --to 15
+
+  # The live topology against a saved snapshot, as JSON
+  lattice diff --from-file before.json --format json`,
+	Args: cobra.NoArgs,
+	RunE: runDiff,
+}
+
+var diffFlags struct {
+	from     string
+	to       string
+	fromFile string
+	toFile   string
+	format   string
+}
+
+func init() {
+	diffCmd.Flags().StringVar(&diffFlags.from, "from", "", "topology to compare from: live, a revision or a time")
+	diffCmd.Flags().StringVar(&diffFlags.to, "to", "live", "topology to compare to: live, a revision or a time")
+	diffCmd.Flags().StringVar(&diffFlags.fromFile, "from-file", "", "compare from a topology snapshot file")
+	diffCmd.Flags().StringVar(&diffFlags.toFile, "to-file", "", "compare to a topology snapshot file")
+	diffCmd.Flags().StringVar(&diffFlags.format, "format", "text", "output format: text or json")
+	diffCmd.MarkFlagsMutuallyExclusive("from", "from-file")
+	diffCmd.MarkFlagsMutuallyExclusive("to", "to-file")
+	rootCmd.AddCommand(diffCmd)
+}
+
+func runDiff(cmd *cobra.Command, args []string) error {
+	if diffFlags.format != "text" && diffFlags.format != "json" {
+		return fmt.Errorf("invalid format %q: must be text or json", diffFlags.format)
+	}
+	if diffFlags.from == "" && diffFlags.fromFile == "" {
+		return errors.New("one of --from or --from-file is required")
+	}
+
+	now := time.Now()
+	from, err := topologyRef(diffFlags.from, diffFlags.fromFile, now)
+	if err != nil {
+		return err
+	}
+	to, err := topologyRef(diffFlags.to, diffFlags.toFile, now)
+	if err != nil {
+		return err
+	}
+
+	client := newObserverClient()
+	resp, err := client.DiffTopology(cmd.Context(), connect.NewRequest(&observerv1.DiffTopologyRequest{
+		From: from,
+		To:   to,
+	}))
+	if err != nil {
+		return err
+	}
+
+	if diffFlags.format == "json" {
+		out, err := protojson.MarshalOptions{Multiline: true}.Marshal(resp.Msg)
+		if err != nil {
+			return fmt.Errorf("failed to encode diff: %w", err)
+		}
+		fmt.Fprintln(cmd.OutOrStdout(), string(out))
+		return nil
+	}
+
+	printTopologyDiff(cmd.OutOrStdout(), resp.Msg,
+		sideLabel(diffFlags.fromFile), sideLabel(diffFlags.toFile))
+	return nil
+}
+
+// sideLabel names a side of a diff that isn't a recorded revision
+func sideLabel(file string) string {
+	if file != "" {
+		return "snapshot " + file
+	}
+	return "live topology"
+}
+
+// topologyRef builds a ref from a --from/--to value or a snapshot file.
+// Values are "live", a revision number or a time.
+func topologyRef(value, file string, now time.Time) (*observerv1.TopologyRef, error) {
+	if file != "" {
+		snapshot, err := readSnapshot(file)
+		if err != nil {
+			return nil, err
+		}
+		return &observerv1.TopologyRef{Ref: &observerv1.TopologyRef_Snapshot{Snapshot: snapshot}}, nil
+	}
+
+	if value == "" || value == "live" {
+		return nil, nil
+	}
+
+	if rev, err := strconv.ParseUint(value, 10, 64); err == nil {
+		return &observerv1.TopologyRef{Ref: &observerv1.TopologyRef_Revision{Revision: rev}}, nil
+	}
+
+	t, err := parseTime(value, now)
+	if err != nil {
+		return nil, err
+	}
+	return &observerv1.TopologyRef{Ref: &observerv1.TopologyRef_Timestamp{Timestamp: t.UnixMilli()}}, nil
+}
+
+// readSnapshot reads a topology saved as JSON. Both a bare topology and a
+// GetTopology or GetTopologyAt response are accepted.
+func readSnapshot(path string) (*observerv1.Topology, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read snapshot: %w", err)
+	}
+
+	unmarshal := protojson.UnmarshalOptions{DiscardUnknown: true}
+
+	var wrapped observerv1.GetTopologyAtResponse
+	if err := unmarshal.Unmarshal(data, &wrapped); err == nil && wrapped.Topology != nil {
+		return wrapped.Topology, nil
+	}
+
+	var topology observerv1.Topology
+	if err := unmarshal.Unmarshal(data, &topology); err != nil {
+		return nil, fmt.Errorf("failed to parse snapshot %s: %w", path, err)
+	}
+	return &topology, nil
+}
+
+// printTopologyDiff prints a line per added, removed and changed service,
+// with the changed fields of each indented below it. The labels name sides
+// that aren't recorded revisions.
+func printTopologyDiff(w io.Writer, resp *observerv1.DiffTopologyResponse, fromLabel, toLabel string) {
+	fmt.Fprintf(w, "Comparing %s with %s\n\n",
+		describeSide(fromLabel, resp.FromRevision, resp.FromTimestamp),
+		describeSide(toLabel, resp.ToRevision, resp.ToTimestamp))
+
+	if len(resp.Added) == 0 && len(resp.Removed) == 0 && len(resp.Changed) == 0 {
+		fmt.Fprintln(w, "No differences")
+		return
+	}
+
+	for _, svc := range resp.Added {
+		fmt.Fprintf(w, "+ %s on %s%s\n", svc.Name, svc.NodeName, serviceSummary(svc))
+	}
+	for _, svc := range resp.Removed {
+		fmt.Fprintf(w, "- %s on %s%s\n", svc.Name, svc.NodeName, serviceSummary(svc))
+	}
+	for _, d := range resp.Changed {
+		fmt.Fprintf(w, "~ %s on %s\n", d.Name, d.NodeName)
+
+		if d.Type != nil {
+			fmt.Fprintf(w, "    type: %s -> %s\n", d.Type.Before, d.Type.After)
+		}
+		if d.Address != nil {
+			fmt.Fprintf(w, "    address: %s -> %s\n", d.Address.Before, d.Address.After)
+		}
+		if d.Status != nil {
+			fmt.Fprintf(w, "    status: %s -> %s\n",
+				strings.TrimPrefix(d.Status.Before, "SERVICE_STATUS_"), strings.TrimPrefix(d.Status.After, "SERVICE_STATUS_"))
+		}
+		for _, up := range d.UpstreamsAdded {
+			fmt.Fprintf(w, "    upstream added: %s\n", up)
+		}
+		for _, up := range d.UpstreamsRemoved {
+			fmt.Fprintf(w, "    upstream removed: %s\n", up)
+		}
+		for _, tag := range d.Tags {
+			switch {
+			case tag.Added:
+				fmt.Fprintf(w, "    tag %s added: %s\n", tag.Key, tag.After)
+			case tag.Removed:
+				fmt.Fprintf(w, "    tag %s removed: %s\n", tag.Key, tag.Before)
+			default:
+				fmt.Fprintf(w, "    tag %s: %s -> %s\n", tag.Key, tag.Before, tag.After)
+			}
+		}
+	}
+
+	fmt.Fprintf(w, "\n%d added, %d removed, %d changed\n", len(resp.Added), len(resp.Removed), len(resp.Changed))
+}
+
+// describeSide describes one side of a diff. Revision 0 is the live
+// topology or a snapshot, named by label.
+func describeSide(label string, revision uint64, timestamp int64) string {
+	if revision != 0 {
+		label = fmt.Sprintf("revision %d", revision)
+	}
+	if timestamp == 0 {
+		return label
+	}
+	return fmt.Sprintf("%s (%s)", label, formatTime(timestamp))
+}
+
+// serviceSummary returns the type, address and status of a service in
+// parentheses, or nothing if none are set
+func serviceSummary(svc *observerv1.Service) string {
+	var parts []string
+	for _, part := range []string{svc.Type, svc.Address} {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	if svc.Status != observerv1.ServiceStatus_SERVICE_STATUS_UNSPECIFIED {
+		parts = append(parts, statusName(svc.Status))
+	}
+	if len(parts) == 0 {
+		return ""
+	}
+	return " (" + strings.Join(parts, ", ") + ")"
+}
@@ -0,0 +1,168 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"connectrpc.com/connect"
+	observerv1 "github.com/jumppad-labs/lattice/pkg/api/observer/v1"
+	"github.com/spf13/cobra"
+)
+
+var historyCmd = &cobra.Command{
+	Use:   "history",
+	Short: "Browse recorded topology changes",
+	Long: `Browse the topology history recorded by a running Lattice server. The server
+needs a topology_history block in its config.
+
+Times are RFC 3339 timestamps or durations before now, e.g. "20m".
+
+Examples:
+  # Changes in the last hour
+  lattice history
+
+  # Changes during an incident
+  lattice history --since 2024-01-02T15:00:00Z --until 2024-01-02T16:00:00Z
+
+  # The mesh as it was twenty minutes ago
+  lattice history --at 20m`,
+	Args: cobra.NoArgs,
+	RunE: runHistory,
+}
+
+var historyFlags struct {
+	since string
+	until string
+	at    string
+}
+
+func init() {
+	historyCmd.Flags().StringVar(&historyFlags.since, "since", "1h", "list changes from this time")
+	historyCmd.Flags().StringVar(&historyFlags.until, "until", "", "list changes before this time (default now)")
+	historyCmd.Flags().StringVar(&historyFlags.at, "at", "", "show the topology at this time instead of listing changes")
+	rootCmd.AddCommand(historyCmd)
+}
+
+func runHistory(cmd *cobra.Command, args []string) error {
+	client := newObserverClient()
+	now := time.Now()
+
+	if historyFlags.at != "" {
+		at, err := parseTime(historyFlags.at, now)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.GetTopologyAt(cmd.Context(), connect.NewRequest(&observerv1.GetTopologyAtRequest{
+			Timestamp: at.UnixMilli(),
+		}))
+		if err != nil {
+			return err
+		}
+
+		printTopologyAt(cmd.OutOrStdout(), resp.Msg)
+		return nil
+	}
+
+	req := &observerv1.ListTopologyChangesRequest{}
+	if historyFlags.since != "" {
+		since, err := parseTime(historyFlags.since, now)
+		if err != nil {
+			return err
+		}
+		req.StartTime = since.UnixMilli()
+	}
+	if historyFlags.until != "" {
+		until, err := parseTime(historyFlags.until, now)
+		if err != nil {
+			return err
+		}
+		req.EndTime = until.UnixMilli()
+	}
+
+	var changes []*observerv1.TopologyChange
+	for {
+		resp, err := client.ListTopologyChanges(cmd.Context(), connect.NewRequest(req))
+		if err != nil {
+			return err
+		}
+
+		changes = append(changes, resp.Msg.Changes...)
+		if resp.Msg.NextCursor == "" {
+			break
+		}
+		req.Cursor = resp.Msg.NextCursor
+	}
+
+	printTopologyChanges(cmd.OutOrStdout(), changes)
+	return nil
+}
+
+// printTopologyAt prints the services of a recorded revision
+func printTopologyAt(w io.Writer, resp *observerv1.GetTopologyAtResponse) {
+	fmt.Fprintf(w, "Revision %d, recorded %s\n\n", resp.Revision, formatTime(resp.Topology.GetTimestamp()))
+
+	if len(resp.Topology.GetServices()) == 0 {
+		fmt.Fprintln(w, "No services")
+		return
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "SERVICE\tNODE\tTYPE\tADDRESS\tSTATUS")
+	for _, svc := range resp.Topology.GetServices() {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", svc.Name, svc.NodeName, svc.Type, svc.Address, statusName(svc.Status))
+	}
+	tw.Flush()
+}
+
+// printTopologyChanges prints a line per service changed in each revision
+func printTopologyChanges(w io.Writer, changes []*observerv1.TopologyChange) {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "REVISION\tTIME\tCHANGE\tSERVICE\tNODE\tDETAILS")
+
+	rows := 0
+	for _, change := range changes {
+		row := func(kind string, svc *observerv1.Service, details string) {
+			rows++
+			fmt.Fprintf(tw, "%d\t%s\t%s\t%s\t%s\t%s\n",
+				change.Revision, formatTime(change.Timestamp), kind, svc.Name, svc.NodeName, details)
+		}
+
+		for _, svc := range change.Delta.GetAdded() {
+			row("added", svc, statusName(svc.Status))
+		}
+		for _, svc := range change.Delta.GetRemoved() {
+			row("removed", svc, "")
+		}
+		for _, c := range change.Delta.GetChanged() {
+			row("changed", c.Service, changeDetails(c))
+		}
+	}
+
+	// The first revision of an empty mesh has no changes to show
+	if rows == 0 {
+		fmt.Fprintln(w, "No topology changes recorded in this time range")
+		return
+	}
+	tw.Flush()
+}
+
+// changeDetails describes the fields that changed on a service, with the
+// new status if it changed
+func changeDetails(c *observerv1.ServiceChange) string {
+	details := strings.Join(c.Fields, ", ")
+	for _, field := range c.Fields {
+		if field != "status" {
+			continue
+		}
+
+		details += " -> " + statusName(c.Service.Status)
+		if c.Service.StatusReason != "" {
+			details += " (" + c.Service.StatusReason + ")"
+		}
+	}
+	return details
+}
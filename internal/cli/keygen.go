@@ -0,0 +1,33 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/jumppad-labs/lattice/internal/serf"
+	"github.com/spf13/cobra"
+)
+
+var keygenCmd = &cobra.Command{
+	Use:   "keygen",
+	Short: "Generate a gossip encryption key",
+	Long: `Generate a new random key for encrypting gossip traffic.
+
+Use the key as the mesh.encrypt setting on every node, or install it on a
+running mesh with "lattice keyring install".`,
+	Args: cobra.NoArgs,
+	RunE: runKeygen,
+}
+
+func init() {
+	rootCmd.AddCommand(keygenCmd)
+}
+
+func runKeygen(cmd *cobra.Command, args []string) error {
+	key, err := serf.GenerateKey()
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintln(cmd.OutOrStdout(), key)
+	return nil
+}
@@ -0,0 +1,171 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"text/tabwriter"
+
+	"connectrpc.com/connect"
+	"github.com/jumppad-labs/lattice/internal/config"
+	"github.com/jumppad-labs/lattice/internal/serf"
+	observerv1 "github.com/jumppad-labs/lattice/pkg/api/observer/v1"
+	"github.com/jumppad-labs/lattice/pkg/api/observer/v1/observerapiconnect"
+	"github.com/spf13/cobra"
+)
+
+var keyringCmd = &cobra.Command{
+	Use:   "keyring",
+	Short: "Manage gossip encryption keys",
+	Long: `Manage the gossip encryption keyring across the mesh via a running Lattice server.
+
+The keyring is managed through the server's admin API, set by server.admin
+(default ` + config.DefaultAdminListen + `). Keys are listed by fingerprint; use
+"lattice keyring fingerprint" to find the fingerprint of a key.
+
+To rotate keys, install the new key, make it the primary key once it is
+installed on every node, then remove the old key.`,
+}
+
+var keyringFingerprintCmd = &cobra.Command{
+	Use:   "fingerprint <key>",
+	Short: "Print the fingerprint a key is listed by",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if _, err := serf.DecodeKey(args[0]); err != nil {
+			return err
+		}
+		fmt.Fprintln(cmd.OutOrStdout(), serf.KeyFingerprint(args[0]))
+		return nil
+	},
+}
+
+// adminAddrEnv overrides config.DefaultAdminListen for keyring commands
+const adminAddrEnv = "LATTICE_ADMIN_ADDR"
+
+// adminAddr is the address of the admin API, set by the keyring --admin-address
+// flag
+var adminAddr string
+
+var keyringListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List keys installed on members of the mesh",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		resp, err := newKeyringClient().ListKeys(cmd.Context(),
+			connect.NewRequest(&observerv1.ListKeysRequest{}))
+		if err != nil {
+			return err
+		}
+		return printKeyringResult(cmd.OutOrStdout(), resp.Msg.Result, true)
+	},
+}
+
+var keyringInstallCmd = &cobra.Command{
+	Use:   "install <key>",
+	Short: "Install a new key on all members of the mesh",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		resp, err := newKeyringClient().InstallKey(cmd.Context(),
+			connect.NewRequest(&observerv1.InstallKeyRequest{Key: args[0]}))
+		if err != nil {
+			return err
+		}
+		return printKeyringResult(cmd.OutOrStdout(), resp.Msg.Result, false)
+	},
+}
+
+var keyringUseCmd = &cobra.Command{
+	Use:   "use <key>",
+	Short: "Make an installed key the primary encryption key",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		resp, err := newKeyringClient().UseKey(cmd.Context(),
+			connect.NewRequest(&observerv1.UseKeyRequest{Key: args[0]}))
+		if err != nil {
+			return err
+		}
+		return printKeyringResult(cmd.OutOrStdout(), resp.Msg.Result, false)
+	},
+}
+
+var keyringRemoveCmd = &cobra.Command{
+	Use:   "remove <key>",
+	Short: "Remove a key from all members of the mesh",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		resp, err := newKeyringClient().RemoveKey(cmd.Context(),
+			connect.NewRequest(&observerv1.RemoveKeyRequest{Key: args[0]}))
+		if err != nil {
+			return err
+		}
+		return printKeyringResult(cmd.OutOrStdout(), resp.Msg.Result, false)
+	},
+}
+
+func init() {
+	addr := os.Getenv(adminAddrEnv)
+	if addr == "" {
+		addr = config.DefaultAdminListen
+	}
+	keyringCmd.PersistentFlags().StringVar(&adminAddr, "admin-address", addr,
+		"address of the Lattice server admin API (env "+adminAddrEnv+")")
+
+	keyringCmd.AddCommand(keyringListCmd, keyringInstallCmd, keyringUseCmd, keyringRemoveCmd, keyringFingerprintCmd)
+	rootCmd.AddCommand(keyringCmd)
+}
+
+// newKeyringClient creates a client for the server's Keyring API
+func newKeyringClient() observerapiconnect.KeyringServiceClient {
+	return observerapiconnect.NewKeyringServiceClient(httpClient, serverBaseURL(adminAddr))
+}
+
+// printKeyringResult prints per-node errors and, if listKeys is set, the
+// installed keys. It returns an error if any node failed.
+func printKeyringResult(w io.Writer, result *observerv1.KeyringResult, listKeys bool) error {
+	if result == nil {
+		return fmt.Errorf("empty response from server")
+	}
+
+	if len(result.Messages) > 0 {
+		nodes := make([]string, 0, len(result.Messages))
+		for node := range result.Messages {
+			nodes = append(nodes, node)
+		}
+		sort.Strings(nodes)
+
+		fmt.Fprintln(w, "Errors:")
+		for _, node := range nodes {
+			fmt.Fprintf(w, "  %s: %s\n", node, result.Messages[node])
+		}
+		fmt.Fprintln(w)
+	}
+
+	if listKeys {
+		keys := make([]string, 0, len(result.Keys))
+		for key := range result.Keys {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(tw, "FINGERPRINT\tINSTALLED\tPRIMARY")
+		for _, key := range keys {
+			fmt.Fprintf(tw, "%s\t%d/%d\t%d/%d\n", key,
+				result.Keys[key], result.NumNodes,
+				result.PrimaryKeys[key], result.NumNodes)
+		}
+		tw.Flush()
+	}
+
+	if result.NumErrors > 0 {
+		return fmt.Errorf("%d of %d nodes reported an error", result.NumErrors, result.NumNodes)
+	}
+
+	if !listKeys {
+		fmt.Fprintf(w, "Success: %d of %d nodes responded\n", result.NumResponses, result.NumNodes)
+	}
+
+	return nil
+}
@@ -0,0 +1,391 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"math"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	observerv1 "github.com/jumppad-labs/lattice/pkg/api/observer/v1"
+	"github.com/jumppad-labs/lattice/pkg/api/observer/v1/observerapiconnect"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// logsRetryInterval is how long a follow waits before reconnecting a
+	// broken log stream
+	logsRetryInterval = time.Second
+
+	// logsDiscoverInterval is how often --all --follow looks for services
+	// that joined the mesh
+	logsDiscoverInterval = 5 * time.Second
+)
+
+var logsCmd = &cobra.Command{
+	Use:   "logs [service]",
+	Short: "Print request logs of a service",
+	Long: `Print the most recent request logs of a service, fetched through a running
+Lattice server.
+
+Status filters are a code (404), a class (5xx) or a range (400-499). Times are
+RFC 3339 timestamps or durations before now, e.g. "20m". Status codes are
+colored when writing to a terminal, unless NO_COLOR is set.
+
+Examples:
+  # Recent logs of the users service
+  lattice logs users
+
+  # Follow server errors of every service
+  lattice logs --all --status 5xx -f
+
+  # POST and PUT requests in the last ten minutes
+  lattice logs orders --method POST,PUT --since 10m`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runLogs,
+}
+
+var logsFlags struct {
+	follow  bool
+	since   string
+	status  string
+	methods []string
+	all     bool
+	lines   int
+}
+
+func init() {
+	logsCmd.Flags().BoolVarP(&logsFlags.follow, "follow", "f", false, "keep printing new logs as they arrive")
+	logsCmd.Flags().StringVar(&logsFlags.since, "since", "", "only print logs from this time")
+	logsCmd.Flags().StringVar(&logsFlags.status, "status", "", "only print logs with this status code, class or range")
+	logsCmd.Flags().StringSliceVar(&logsFlags.methods, "method", nil, "only print logs with these HTTP methods")
+	logsCmd.Flags().BoolVar(&logsFlags.all, "all", false, "print logs of every service, prefixed with the service name")
+	logsCmd.Flags().IntVarP(&logsFlags.lines, "lines", "n", 100, "number of recent logs to print; with --since, every log since then unless set")
+	rootCmd.AddCommand(logsCmd)
+}
+
+func runLogs(cmd *cobra.Command, args []string) error {
+	if logsFlags.all == (len(args) == 1) {
+		return errors.New("specify a service or --all")
+	}
+	if logsFlags.lines < 0 {
+		return errors.New("--lines must not be negative")
+	}
+
+	filter := &observerv1.RequestLogFilter{Methods: logsFlags.methods}
+	if logsFlags.status != "" {
+		var err error
+		if filter.MinStatus, filter.MaxStatus, err = parseStatusFilter(logsFlags.status); err != nil {
+			return err
+		}
+	}
+
+	limit := int32(logsFlags.lines)
+	if logsFlags.since != "" {
+		t, err := parseTime(logsFlags.since, time.Now())
+		if err != nil {
+			return err
+		}
+		filter.StartTime = t.UnixMilli()
+
+		// Print everything since then unless a number of lines was asked for
+		if !cmd.Flags().Changed("lines") {
+			limit = math.MaxInt32
+		}
+	}
+
+	client := newObserverClient()
+	p := &logPrinter{
+		w:      cmd.OutOrStdout(),
+		color:  useColor(cmd.OutOrStdout()),
+		prefix: logsFlags.all,
+	}
+
+	req := &observerv1.GetAggregatedRequestLogsRequest{
+		Filter: filter,
+		Limit:  limit,
+	}
+	if !logsFlags.all {
+		req.ServiceNames = args
+	}
+	resp, err := client.GetAggregatedRequestLogs(cmd.Context(), connect.NewRequest(req))
+	if err != nil {
+		return err
+	}
+
+	for _, e := range resp.Msg.Errors {
+		if !logsFlags.all {
+			return fmt.Errorf("%s: %s", e.Code, e.Message)
+		}
+		fmt.Fprintf(cmd.ErrOrStderr(), "Warning: failed to fetch logs of %s: %s\n", e.ServiceName, e.Message)
+	}
+
+	services := slices.Sorted(maps.Keys(resp.Msg.LatestSequences))
+	p.widen(services)
+	if logsFlags.lines > 0 {
+		for _, entry := range resp.Msg.Logs {
+			p.print(entry.ServiceName, entry.Log)
+		}
+	}
+
+	if !logsFlags.follow {
+		return nil
+	}
+
+	f := &logFollower{
+		client:  client,
+		filter:  filter,
+		printer: p,
+		errOut:  cmd.ErrOrStderr(),
+		watched: make(map[string]bool),
+	}
+	for _, service := range services {
+		f.watch(cmd.Context(), service, resp.Msg.LatestSequences[service])
+	}
+
+	if !logsFlags.all {
+		<-cmd.Context().Done()
+		return nil
+	}
+
+	// Follow services that join after the command started
+	ticker := time.NewTicker(logsDiscoverInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-cmd.Context().Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		topology, err := client.GetTopology(cmd.Context(), connect.NewRequest(&observerv1.GetTopologyRequest{}))
+		if err != nil {
+			continue
+		}
+		for _, svc := range topology.Msg.Topology.GetServices() {
+			f.discover(cmd.Context(), svc.Name)
+		}
+	}
+}
+
+// parseStatusFilter parses a status code (404), class (5xx) or range
+// (400-499) into inclusive bounds
+func parseStatusFilter(value string) (int32, int32, error) {
+	invalid := fmt.Errorf("invalid status %q: use a code (404), class (5xx) or range (400-499)", value)
+
+	if len(value) == 3 && strings.HasSuffix(strings.ToLower(value), "xx") {
+		class, err := strconv.Atoi(value[:1])
+		if err != nil || class < 1 || class > 5 {
+			return 0, 0, invalid
+		}
+		return int32(class * 100), int32(class*100 + 99), nil
+	}
+
+	low, high, isRange := strings.Cut(value, "-")
+	if !isRange {
+		high = low
+	}
+	lowCode, err := strconv.Atoi(low)
+	if err != nil {
+		return 0, 0, invalid
+	}
+	highCode, err := strconv.Atoi(high)
+	if err != nil || lowCode < 100 || highCode > 599 || lowCode > highCode {
+		return 0, 0, invalid
+	}
+	return int32(lowCode), int32(highCode), nil
+}
+
+// logFollower streams new logs of each watched service to a printer
+type logFollower struct {
+	client  observerapiconnect.ObserverServiceClient
+	filter  *observerv1.RequestLogFilter
+	printer *logPrinter
+	errOut  io.Writer
+
+	mu      sync.Mutex
+	watched map[string]bool
+}
+
+// watch starts streaming a service's logs after a sequence, unless it is
+// already watched
+func (f *logFollower) watch(ctx context.Context, service string, after uint64) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.watched[service] {
+		return
+	}
+	f.watched[service] = true
+
+	go f.stream(ctx, service, after)
+}
+
+// discover starts watching a service that joined after the command started,
+// from its latest log so that its buffered history isn't replayed. If the
+// latest sequence can't be fetched, the next discovery tries again.
+func (f *logFollower) discover(ctx context.Context, service string) {
+	if f.isWatched(service) {
+		return
+	}
+
+	resp, err := f.client.GetRequestLogs(ctx, connect.NewRequest(&observerv1.GetRequestLogsRequest{
+		ServiceName: service,
+		Limit:       1,
+	}))
+	if err != nil {
+		return
+	}
+
+	f.watch(ctx, service, resp.Msg.LatestSequence)
+}
+
+// isWatched reports whether a service is already watched
+func (f *logFollower) isWatched(service string) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.watched[service]
+}
+
+// stream prints a service's logs until ctx is done, reconnecting from the
+// last printed sequence when the stream breaks
+func (f *logFollower) stream(ctx context.Context, service string, after uint64) {
+	for ctx.Err() == nil {
+		stream, err := f.client.WatchRequestLogs(ctx, connect.NewRequest(&observerv1.WatchRequestLogsRequest{
+			ServiceName:   service,
+			AfterSequence: after,
+			Filter:        f.filter,
+		}))
+		if err == nil {
+			for stream.Receive() {
+				msg := stream.Msg()
+				if msg.Restarted {
+					f.printer.notice(service, "service restarted")
+					after = 0
+				}
+				if msg.Truncated {
+					f.printer.notice(service, "some logs were dropped before they could be sent")
+				}
+				for _, entry := range msg.Logs {
+					f.printer.print(service, entry)
+					after = entry.Sequence
+				}
+			}
+			err = stream.Err()
+			stream.Close()
+		}
+
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			fmt.Fprintf(f.errOut, "Warning: log stream of %s failed, retrying: %v\n", service, err)
+		}
+
+		select {
+		case <-ctx.Done():
+		case <-time.After(logsRetryInterval):
+		}
+	}
+}
+
+// logPrinter writes log lines, serializing writes from concurrent streams
+type logPrinter struct {
+	w      io.Writer
+	color  bool
+	prefix bool // Prefix each line with its service
+
+	mu    sync.Mutex
+	width int // Width of the service prefix
+}
+
+// widen makes room in the service prefix for the given services
+func (p *logPrinter) widen(services []string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, service := range services {
+		p.width = max(p.width, len(service))
+	}
+}
+
+// print writes one log line
+func (p *logPrinter) print(service string, entry *observerv1.RequestLog) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var b strings.Builder
+	if p.prefix {
+		p.width = max(p.width, len(service))
+		fmt.Fprintf(&b, "%-*s | ", p.width, service)
+	}
+	fmt.Fprintf(&b, "%s  %-7s %s  %s  %dms  %s",
+		time.UnixMilli(entry.Timestamp).Format("2006-01-02 15:04:05.000"),
+		entry.Method,
+		entry.Path,
+		p.status(entry.Status),
+		entry.DurationMs,
+		entry.Level,
+	)
+	fmt.Fprintln(p.w, b.String())
+}
+
+// notice writes a line about a service's log stream
+func (p *logPrinter) notice(service, message string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	fmt.Fprintf(p.w, "--- %s: %s ---\n", service, message)
+}
+
+// ANSI colors for status codes
+const (
+	ansiReset  = "\x1b[0m"
+	ansiGreen  = "\x1b[32m"
+	ansiCyan   = "\x1b[36m"
+	ansiYellow = "\x1b[33m"
+	ansiRed    = "\x1b[31m"
+)
+
+// status formats a status code, colored by class if enabled
+func (p *logPrinter) status(code int32) string {
+	s := strconv.Itoa(int(code))
+	if !p.color {
+		return s
+	}
+
+	switch {
+	case code >= 500:
+		return ansiRed + s + ansiReset
+	case code >= 400:
+		return ansiYellow + s + ansiReset
+	case code >= 300:
+		return ansiCyan + s + ansiReset
+	case code >= 200:
+		return ansiGreen + s + ansiReset
+	default:
+		return s
+	}
+}
+
+// useColor reports whether w is a terminal and NO_COLOR is unset
+func useColor(w io.Writer) bool {
+	if os.Getenv("NO_COLOR") != "" {
+		return false
+	}
+	f, ok := w.(*os.File)
+	if !ok {
+		return false
+	}
+	info, err := f.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
@@ -0,0 +1,50 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseStatusFilter(t *testing.T) {
+	tests := []struct {
+		value string
+		min   int32
+		max   int32
+		valid bool
+	}{
+		{"404", 404, 404, true},
+		{"100", 100, 100, true},
+		{"599", 599, 599, true},
+		{"2xx", 200, 299, true},
+		{"5XX", 500, 599, true},
+		{"1xx", 100, 199, true},
+		{"400-499", 400, 499, true},
+		{"500-500", 500, 500, true},
+		{"0xx", 0, 0, false},
+		{"6xx", 0, 0, false},
+		{"xxx", 0, 0, false},
+		{"4x4", 0, 0, false},
+		{"499-400", 0, 0, false},
+		{"99", 0, 0, false},
+		{"600", 0, 0, false},
+		{"400-600", 0, 0, false},
+		{"400-", 0, 0, false},
+		{"-404", 0, 0, false},
+		{"ok", 0, 0, false},
+		{"", 0, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			low, high, err := parseStatusFilter(tt.value)
+			if !tt.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.min, low)
+			require.Equal(t, tt.max, high)
+		})
+	}
+}
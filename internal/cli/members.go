@@ -0,0 +1,122 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"slices"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"connectrpc.com/connect"
+	observerv1 "github.com/jumppad-labs/lattice/pkg/api/observer/v1"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+var membersCmd = &cobra.Command{
+	Use:   "members",
+	Short: "List the nodes in the mesh",
+	Long: `List the nodes in the gossip mesh of a running Lattice server.
+
+Filters combine, so a node must match the status and every tag given.
+
+Examples:
+  # Every node
+  lattice members
+
+  # Failed nodes in one zone, with their tags
+  lattice members --status failed --tag zone=eu-west-1a --format wide`,
+	Args: cobra.NoArgs,
+	RunE: runMembers,
+}
+
+var membersFlags struct {
+	status string
+	tags   []string
+	format string
+}
+
+func init() {
+	membersCmd.Flags().StringVar(&membersFlags.status, "status", "", "only list nodes with this status: alive, leaving, left or failed")
+	membersCmd.Flags().StringArrayVar(&membersFlags.tags, "tag", nil, "only list nodes with this tag, as key=value (repeatable)")
+	membersCmd.Flags().StringVar(&membersFlags.format, "format", "table", "output format: table, wide or json")
+	rootCmd.AddCommand(membersCmd)
+}
+
+func runMembers(cmd *cobra.Command, args []string) error {
+	switch membersFlags.format {
+	case "table", "wide", "json":
+	default:
+		return fmt.Errorf("invalid format %q: must be table, wide or json", membersFlags.format)
+	}
+
+	req := &observerv1.ListMembersRequest{Status: membersFlags.status}
+	for _, tag := range membersFlags.tags {
+		key, value, ok := strings.Cut(tag, "=")
+		if !ok || key == "" {
+			return fmt.Errorf("invalid tag %q: must be key=value", tag)
+		}
+		if req.Tags == nil {
+			req.Tags = make(map[string]string)
+		}
+		req.Tags[key] = value
+	}
+
+	resp, err := newObserverClient().ListMembers(cmd.Context(), connect.NewRequest(req))
+	if err != nil {
+		return err
+	}
+
+	if membersFlags.format == "json" {
+		out, err := protojson.MarshalOptions{Multiline: true}.Marshal(resp.Msg)
+		if err != nil {
+			return fmt.Errorf("failed to encode members: %w", err)
+		}
+		fmt.Fprintln(cmd.OutOrStdout(), string(out))
+		return nil
+	}
+
+	printMembers(cmd.OutOrStdout(), resp.Msg.Members, membersFlags.format == "wide")
+	return nil
+}
+
+// printMembers prints a row per member, with its tags if wide is set
+func printMembers(w io.Writer, members []*observerv1.Member, wide bool) {
+	if len(members) == 0 {
+		fmt.Fprintln(w, "No members")
+		return
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	header := "NAME\tADDRESS\tSTATUS\tSERVICES"
+	if wide {
+		header += "\tTAGS"
+	}
+	fmt.Fprintln(tw, header)
+
+	for _, m := range members {
+		row := fmt.Sprintf("%s\t%s\t%s\t%s", m.Name,
+			net.JoinHostPort(m.Address, strconv.Itoa(int(m.Port))), m.Status, strings.Join(m.Services, ","))
+		if wide {
+			row += "\t" + formatTags(m.Tags)
+		}
+		fmt.Fprintln(tw, row)
+	}
+	tw.Flush()
+}
+
+// formatTags formats tags as sorted key=value pairs. The services tag is
+// left out, its services are listed in their own column.
+func formatTags(tags map[string]string) string {
+	pairs := make([]string, 0, len(tags))
+	for key, value := range tags {
+		if key == "services" {
+			continue
+		}
+		pairs = append(pairs, key+"="+value)
+	}
+	slices.Sort(pairs)
+	return strings.Join(pairs, ",")
+}
@@ -0,0 +1,45 @@
+package cli
+
+import (
+	"bytes"
+	"testing"
+
+	observerv1 "github.com/jumppad-labs/lattice/pkg/api/observer/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrintMembers(t *testing.T) {
+	members := []*observerv1.Member{
+		{
+			Name:    "lattice",
+			Address: "10.0.0.1",
+			Port:    7946,
+			Status:  "alive",
+			Tags:    map[string]string{"role": "observer"},
+		},
+		{
+			Name:     "polymorph-1",
+			Address:  "fd00::1",
+			Port:     7946,
+			Status:   "failed",
+			Tags:     map[string]string{"zone": "a", "services": `[{"name":"users"}]`, "env": "prod"},
+			Services: []string{"users", "orders"},
+		},
+	}
+
+	var out bytes.Buffer
+	printMembers(&out, members, false)
+	require.Equal(t, "NAME         ADDRESS         STATUS  SERVICES\n"+
+		"lattice      10.0.0.1:7946   alive   \n"+
+		"polymorph-1  [fd00::1]:7946  failed  users,orders\n", out.String())
+
+	out.Reset()
+	printMembers(&out, members, true)
+	require.Equal(t, "NAME         ADDRESS         STATUS  SERVICES      TAGS\n"+
+		"lattice      10.0.0.1:7946   alive                 role=observer\n"+
+		"polymorph-1  [fd00::1]:7946  failed  users,orders  env=prod,zone=a\n", out.String())
+
+	out.Reset()
+	printMembers(&out, nil, true)
+	require.Equal(t, "No members\n", out.String())
+}
@@ -0,0 +1,215 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"connectrpc.com/connect"
+	observerv1 "github.com/jumppad-labs/lattice/pkg/api/observer/v1"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/encoding/prototext"
+)
+
+var resourcesCmd = &cobra.Command{
+	Use:   "resources <service>",
+	Short: "Print the resources and field schemas of a service",
+	Long: `Print the resources a service exposes, fetched through a running Lattice
+server, with each field's type, enum values and numeric range.
+
+The jsonschema format prints a JSON Schema (draft 2020-12) document with a
+definition per resource, for use in contract tests.
+
+Examples:
+  # Resources of the users service
+  lattice resources users
+
+  # JSON Schema of the orders service
+  lattice resources orders --format jsonschema > orders.schema.json`,
+	Args: cobra.ExactArgs(1),
+	RunE: runResources,
+}
+
+var resourcesFlags struct {
+	format string
+}
+
+func init() {
+	resourcesCmd.Flags().StringVar(&resourcesFlags.format, "format", "table", "output format: table, json, proto-text or jsonschema")
+	rootCmd.AddCommand(resourcesCmd)
+}
+
+func runResources(cmd *cobra.Command, args []string) error {
+	switch resourcesFlags.format {
+	case "table", "json", "proto-text", "jsonschema":
+	default:
+		return fmt.Errorf("invalid format %q: must be table, json, proto-text or jsonschema", resourcesFlags.format)
+	}
+
+	resp, err := newObserverClient().GetServiceResources(cmd.Context(), connect.NewRequest(&observerv1.GetServiceResourcesRequest{
+		ServiceName: args[0],
+	}))
+	if err != nil {
+		return err
+	}
+
+	w := cmd.OutOrStdout()
+	switch resourcesFlags.format {
+	case "json":
+		out, err := protojson.MarshalOptions{Multiline: true}.Marshal(resp.Msg)
+		if err != nil {
+			return fmt.Errorf("failed to encode resources: %w", err)
+		}
+		fmt.Fprintln(w, string(out))
+	case "proto-text":
+		out, err := prototext.MarshalOptions{Multiline: true}.Marshal(resp.Msg)
+		if err != nil {
+			return fmt.Errorf("failed to encode resources: %w", err)
+		}
+		fmt.Fprint(w, string(out))
+	case "jsonschema":
+		out, err := json.MarshalIndent(resourceSchema(args[0], resp.Msg.Resources), "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to encode schema: %w", err)
+		}
+		fmt.Fprintln(w, string(out))
+	default:
+		printResources(w, resp.Msg.Resources)
+	}
+	return nil
+}
+
+// printResources prints each resource followed by a table of its fields
+func printResources(w io.Writer, resources []*observerv1.Resource) {
+	if len(resources) == 0 {
+		fmt.Fprintln(w, "No resources")
+		return
+	}
+
+	for i, r := range resources {
+		if i > 0 {
+			fmt.Fprintln(w)
+		}
+
+		fmt.Fprintf(w, "%s (%s, %d rows)\n", r.Name, r.PluralName, r.RowCount)
+		if len(r.Fields) == 0 {
+			fmt.Fprintln(w, "  No fields")
+			continue
+		}
+
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(tw, "  FIELD\tTYPE\tVALUES\tMIN\tMAX")
+		for _, f := range r.Fields {
+			fmt.Fprintf(tw, "  %s\t%s\t%s\t%s\t%s\n", f.Name, f.Type,
+				strings.Join(f.Values, ","), formatBound(f.Min), formatBound(f.Max))
+		}
+		tw.Flush()
+	}
+}
+
+// formatBound formats an optional min or max, empty if unset
+func formatBound(v *float64) string {
+	if v == nil {
+		return ""
+	}
+	return strconv.FormatFloat(*v, 'g', -1, 64)
+}
+
+// jsonSchema is the subset of JSON Schema used to describe resources
+type jsonSchema struct {
+	Schema     string                 `json:"$schema,omitempty"`
+	Title      string                 `json:"title,omitempty"`
+	Type       string                 `json:"type,omitempty"`
+	Format     string                 `json:"format,omitempty"`
+	Enum       []any                  `json:"enum,omitempty"`
+	Minimum    *float64               `json:"minimum,omitempty"`
+	Maximum    *float64               `json:"maximum,omitempty"`
+	Properties map[string]*jsonSchema `json:"properties,omitempty"`
+	Required   []string               `json:"required,omitempty"`
+	Defs       map[string]*jsonSchema `json:"$defs,omitempty"`
+}
+
+// resourceSchema builds a JSON Schema document for a service, with an object
+// definition per resource. Services return every field of a resource, so all
+// of them are required.
+func resourceSchema(service string, resources []*observerv1.Resource) *jsonSchema {
+	doc := &jsonSchema{
+		Schema: "https://json-schema.org/draft/2020-12/schema",
+		Title:  service,
+		Defs:   make(map[string]*jsonSchema, len(resources)),
+	}
+
+	for _, r := range resources {
+		obj := &jsonSchema{
+			Title:      r.Name,
+			Type:       "object",
+			Properties: make(map[string]*jsonSchema, len(r.Fields)),
+		}
+		for _, f := range r.Fields {
+			obj.Properties[f.Name] = fieldSchema(f)
+			obj.Required = append(obj.Required, f.Name)
+		}
+		doc.Defs[r.Name] = obj
+	}
+	return doc
+}
+
+// fieldSchema maps a field to a JSON Schema type. Types that are not known
+// to be numeric, boolean or a string format are treated as plain strings.
+func fieldSchema(f *observerv1.Field) *jsonSchema {
+	s := &jsonSchema{Type: "string"}
+
+	switch strings.ToLower(f.Type) {
+	case "int", "integer", "long":
+		s.Type = "integer"
+	case "float", "double", "decimal", "number", "price":
+		s.Type = "number"
+	case "bool", "boolean":
+		s.Type = "boolean"
+	case "uuid", "email", "date", "time", "uri":
+		s.Format = strings.ToLower(f.Type)
+	case "datetime", "timestamp":
+		s.Format = "date-time"
+	case "url":
+		s.Format = "uri"
+	}
+
+	s.Enum = enumValues(s.Type, f.Values)
+	if s.Type == "integer" || s.Type == "number" {
+		s.Minimum = f.Min
+		s.Maximum = f.Max
+	}
+	return s
+}
+
+// enumValues converts enum values to the schema type so they can match.
+// Values that can't be converted drop the enum rather than reject every
+// instance.
+func enumValues(typ string, values []string) []any {
+	enum := make([]any, 0, len(values))
+	for _, v := range values {
+		var value any = v
+		var err error
+		switch typ {
+		case "integer":
+			value, err = strconv.ParseInt(v, 10, 64)
+		case "number":
+			value, err = strconv.ParseFloat(v, 64)
+		case "boolean":
+			value, err = strconv.ParseBool(v)
+		}
+		if err != nil {
+			return nil
+		}
+		enum = append(enum, value)
+	}
+
+	if len(enum) == 0 {
+		return nil
+	}
+	return enum
+}
@@ -0,0 +1,110 @@
+package cli
+
+import (
+	"encoding/json"
+	"testing"
+
+	observerv1 "github.com/jumppad-labs/lattice/pkg/api/observer/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceSchema(t *testing.T) {
+	resources := []*observerv1.Resource{
+		{
+			Name: "user",
+			Fields: []*observerv1.Field{
+				{Name: "id", Type: "uuid"},
+				{Name: "email", Type: "email"},
+				{Name: "age", Type: "int", Min: ptr(18.0), Max: ptr(99.0)},
+				{Name: "role", Type: "enum", Values: []string{"admin", "member"}},
+				{Name: "created_at", Type: "timestamp"},
+			},
+		},
+		{
+			Name: "order",
+			Fields: []*observerv1.Field{
+				{Name: "total", Type: "price", Min: ptr(0.0)},
+				{Name: "priority", Type: "integer", Values: []string{"1", "2", "3"}},
+				{Name: "discount", Type: "double", Values: []string{"0", "0.1", "0.25"}},
+				{Name: "rank", Type: "int", Values: []string{"low", "high"}},
+				{Name: "paid", Type: "bool", Values: []string{"true", "false"}},
+				{Name: "receipt", Type: "url"},
+			},
+		},
+		{Name: "event"},
+	}
+
+	doc := resourceSchema("shop", resources)
+	require.Equal(t, "https://json-schema.org/draft/2020-12/schema", doc.Schema)
+	require.Equal(t, "shop", doc.Title)
+	require.Len(t, doc.Defs, 3)
+
+	tests := []struct {
+		resource string
+		field    string
+		typ      string
+		format   string
+		enum     []any
+		min      *float64
+		max      *float64
+	}{
+		{resource: "user", field: "id", typ: "string", format: "uuid"},
+		{resource: "user", field: "email", typ: "string", format: "email"},
+		{resource: "user", field: "age", typ: "integer", min: ptr(18.0), max: ptr(99.0)},
+		{resource: "user", field: "role", typ: "string", enum: []any{"admin", "member"}},
+		{resource: "user", field: "created_at", typ: "string", format: "date-time"},
+		{resource: "order", field: "total", typ: "number", min: ptr(0.0)},
+		{resource: "order", field: "priority", typ: "integer", enum: []any{int64(1), int64(2), int64(3)}},
+		{resource: "order", field: "discount", typ: "number", enum: []any{0.0, 0.1, 0.25}},
+		{resource: "order", field: "rank", typ: "integer"},
+		{resource: "order", field: "paid", typ: "boolean", enum: []any{true, false}},
+		{resource: "order", field: "receipt", typ: "string", format: "uri"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.resource+"."+tt.field, func(t *testing.T) {
+			obj := doc.Defs[tt.resource]
+			require.NotNil(t, obj)
+			require.Equal(t, "object", obj.Type)
+
+			s := obj.Properties[tt.field]
+			require.NotNil(t, s)
+			require.Equal(t, tt.typ, s.Type)
+			require.Equal(t, tt.format, s.Format)
+			require.Equal(t, tt.enum, s.Enum)
+			require.Equal(t, tt.min, s.Minimum)
+			require.Equal(t, tt.max, s.Maximum)
+		})
+	}
+
+	require.Equal(t, []string{"id", "email", "age", "role", "created_at"}, doc.Defs["user"].Required)
+	require.Equal(t, []string{"total", "priority", "discount", "rank", "paid", "receipt"}, doc.Defs["order"].Required)
+	require.Empty(t, doc.Defs["event"].Required)
+	require.Empty(t, doc.Defs["event"].Properties)
+}
+
+func TestResourceSchemaJSON(t *testing.T) {
+	doc := resourceSchema("shop", []*observerv1.Resource{{
+		Name: "order",
+		Fields: []*observerv1.Field{
+			{Name: "priority", Type: "int", Values: []string{"1", "2"}, Min: ptr(1.0), Max: ptr(2.0)},
+			{Name: "status", Type: "enum", Values: []string{"open", "closed"}},
+		},
+	}})
+
+	out, err := json.Marshal(doc.Defs["order"])
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"title": "order",
+		"type": "object",
+		"properties": {
+			"priority": {"type": "integer", "enum": [1, 2], "minimum": 1, "maximum": 2},
+			"status": {"type": "string", "enum": ["open", "closed"]}
+		},
+		"required": ["priority", "status"]
+	}`, string(out))
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -1,20 +1,31 @@
 package cli
 
 import (
+	"cmp"
 	"context"
 	"fmt"
 	"log"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"slices"
+	"strconv"
 	"syscall"
 	"time"
 
-	"connectrpc.com/connect"
 	"github.com/jumppad-labs/lattice/internal/api"
 	"github.com/jumppad-labs/lattice/internal/config"
+	"github.com/jumppad-labs/lattice/internal/health"
+	"github.com/jumppad-labs/lattice/internal/history"
+	"github.com/jumppad-labs/lattice/internal/logstore"
+	"github.com/jumppad-labs/lattice/internal/meshdns"
+	"github.com/jumppad-labs/lattice/internal/metrics"
 	"github.com/jumppad-labs/lattice/internal/serf"
+	"github.com/jumppad-labs/lattice/internal/telemetry"
+	"github.com/jumppad-labs/lattice/internal/web"
 	"github.com/jumppad-labs/lattice/pkg/api/observer/v1/observerapiconnect"
+	"github.com/jumppad-labs/lattice/ui"
 	"github.com/spf13/cobra"
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c"
@@ -53,18 +64,19 @@ func runServer(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("invalid config: %w", err)
 	}
 
+	// Build mesh configuration from the server and mesh blocks
+	meshConfig, err := parseMeshConfig(cfg)
+	if err != nil {
+		return fmt.Errorf("failed to parse mesh config: %w", err)
+	}
+
 	log.Printf("Starting Lattice server...")
-	log.Printf("  Gossip mesh: %s", cfg.Server.Listen)
+	log.Printf("  Node name: %s", meshConfig.NodeName)
+	log.Printf("  Gossip mesh: %s", net.JoinHostPort(meshConfig.BindAddr, strconv.Itoa(meshConfig.BindPort)))
 	log.Printf("  Web UI + API: %s", cfg.Server.UI)
 
 	ctx := context.Background()
 
-	// Parse listen address
-	meshConfig, err := parseMeshConfig(cfg.Server.Listen)
-	if err != nil {
-		return fmt.Errorf("failed to parse mesh listen address: %w", err)
-	}
-
 	// Create and start Serf mesh
 	mesh, err := serf.NewMesh(meshConfig)
 	if err != nil {
@@ -75,35 +87,213 @@ func runServer(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to start mesh: %w", err)
 	}
 
-	log.Printf("Serf mesh started on %s", cfg.Server.Listen)
+	log.Printf("Serf mesh started on %s", net.JoinHostPort(meshConfig.BindAddr, strconv.Itoa(meshConfig.BindPort)))
 
 	// Create Observer API service
 	observerSvc := api.NewObserverService(mesh)
 
+	// Record topology revisions if configured
+	if cfg.TopologyHistory != nil {
+		historyOpts, err := parseTopologyHistoryOptions(cfg.TopologyHistory)
+		if err != nil {
+			return fmt.Errorf("failed to parse topology_history config: %w", err)
+		}
+
+		store, err := history.Open(historyOpts)
+		if err != nil {
+			return fmt.Errorf("failed to open topology history: %w", err)
+		}
+		defer store.Close()
+
+		if err := observerSvc.SetHistory(store); err != nil {
+			return err
+		}
+		log.Printf("  Topology history: %s (max %d MB)", historyOpts.Dir, historyOpts.MaxBytes>>20)
+	}
+
+	// Collect request logs for retention and metrics if either is configured
+	var collectInterval time.Duration
+
+	if cfg.LogStore != nil {
+		storeOpts, interval, err := parseLogStoreOptions(cfg.LogStore)
+		if err != nil {
+			return fmt.Errorf("failed to parse log_store config: %w", err)
+		}
+
+		store, err := logstore.Open(storeOpts)
+		if err != nil {
+			return fmt.Errorf("failed to open log store: %w", err)
+		}
+		defer store.Close()
+
+		log.Printf("  Log store: %s (max %d MB)", storeOpts.Dir, storeOpts.MaxBytes>>20)
+		observerSvc.SetLogStore(store)
+		collectInterval = interval
+	}
+
+	if cfg.Metrics != nil {
+		metricsOpts, summaryWindow, interval, err := parseMetricsOptions(cfg.Metrics)
+		if err != nil {
+			return fmt.Errorf("failed to parse metrics config: %w", err)
+		}
+
+		recorder, err := metrics.NewRecorder(metricsOpts)
+		if err != nil {
+			return fmt.Errorf("failed to create metrics recorder: %w", err)
+		}
+
+		log.Printf("  Metrics windows: %v", metricsOpts.Windows)
+		observerSvc.SetMetrics(recorder, summaryWindow)
+
+		// Both share one collector, which polls at the shorter interval
+		if collectInterval == 0 || interval < collectInterval {
+			collectInterval = interval
+		}
+	}
+
+	// Check service health actively and from request error rates if configured
+	if len(cfg.HealthChecks) > 0 {
+		checks, err := parseHealthChecks(cfg.HealthChecks)
+		if err != nil {
+			return fmt.Errorf("failed to parse health_check config: %w", err)
+		}
+		log.Printf("  Health checks: %d", len(checks))
+		observerSvc.SetHealthChecks(checks)
+	}
+
+	if cfg.PassiveHealth != nil {
+		passive, err := parsePassiveHealth(cfg.PassiveHealth)
+		if err != nil {
+			return fmt.Errorf("failed to parse passive_health config: %w", err)
+		}
+		log.Printf("  Passive health: degraded at %g, unhealthy at %g error rate over %s",
+			passive.DegradedErrorRate, passive.UnhealthyErrorRate, passive.Window)
+		observerSvc.SetPassiveHealth(passive)
+	}
+
+	// The service is fully configured, so it can follow the mesh now
+	observerSvc.Start()
+
+	// Background work such as log collection and health checks runs until
+	// shutdown
+	bgCtx, stopBackground := context.WithCancel(ctx)
+	defer stopBackground()
+
+	if collectInterval > 0 {
+		go observerSvc.CollectLogs(bgCtx, collectInterval)
+	}
+	if len(cfg.HealthChecks) > 0 || cfg.PassiveHealth != nil {
+		go observerSvc.RunHealthChecks(bgCtx)
+	}
+
 	// Create HTTP mux
 	mux := http.NewServeMux()
 
-	// Register Connect-RPC API with CORS
-	path, handler := observerapiconnect.NewObserverServiceHandler(
-		observerSvc,
-		connect.WithInterceptors(newCORSInterceptor()),
-	)
+	// Register Connect-RPC API
+	path, handler := observerapiconnect.NewObserverServiceHandler(observerSvc)
 	mux.Handle(path, handler)
 
+	// Serve metrics for Prometheus if configured
+	if cfg.Prometheus != nil {
+		metricsPath := cmp.Or(cfg.Prometheus.Path, config.DefaultPrometheusPath)
+		log.Printf("  Prometheus metrics: %s", metricsPath)
+		mux.Handle(metricsPath, telemetry.Handler(observerSvc))
+	}
+
+	// Serve Prometheus service discovery targets if configured
+	if cfg.PrometheusSD != nil {
+		sdOpts, err := parsePrometheusSDOptions(cfg.PrometheusSD)
+		if err != nil {
+			return fmt.Errorf("failed to parse prometheus_sd config: %w", err)
+		}
+
+		sdPath := cmp.Or(cfg.PrometheusSD.Path, config.DefaultPrometheusSDPath)
+		log.Printf("  Prometheus service discovery: %s", sdPath)
+		mux.Handle(sdPath, observerSvc.PrometheusSDHandler(sdOpts))
+	}
+
+	// Answer DNS queries for healthy service instances if configured
+	var dnsServer *meshdns.Server
+	if cfg.DNS != nil {
+		dnsOpts, err := parseDNSOptions(cfg.DNS)
+		if err != nil {
+			return fmt.Errorf("failed to parse dns config: %w", err)
+		}
+
+		dnsServer, err = meshdns.NewServer(dnsOpts, observerSvc.HealthyInstances)
+		if err != nil {
+			return fmt.Errorf("failed to create DNS server: %w", err)
+		}
+		if err := dnsServer.Start(); err != nil {
+			return fmt.Errorf("failed to start DNS server: %w", err)
+		}
+		log.Printf("  DNS: %s (%s)", dnsServer.Addr(), cmp.Or(dnsOpts.Domain, meshdns.DefaultDomain))
+	}
+
+	// Serve the web UI, either embedded or from disk for development
+	uiFS := ui.Dist()
+	if cfg.Server.UIDir != "" {
+		log.Printf("Serving web UI from %s", cfg.Server.UIDir)
+		uiFS = os.DirFS(cfg.Server.UIDir)
+	}
+	mux.Handle("/", web.NewUIHandler(uiFS))
+
+	// Allow cross-origin browser requests if configured
+	var httpHandler http.Handler = mux
+	if cfg.CORS != nil {
+		corsOpts, err := parseCORSOptions(cfg.CORS)
+		if err != nil {
+			return fmt.Errorf("failed to parse cors config: %w", err)
+		}
+		log.Printf("  CORS origins: %v", corsOpts.AllowedOrigins)
+		httpHandler = web.NewCORS(corsOpts, mux)
+	}
+
 	// Create HTTP server with h2c (HTTP/2 cleartext) support
 	server := &http.Server{
 		Addr:    cfg.Server.UI,
-		Handler: h2c.NewHandler(mux, &http2.Server{}),
+		Handler: h2c.NewHandler(httpHandler, &http2.Server{}),
+	}
+
+	// The keyring API exposes and changes the gossip encryption keys, so it
+	// is served on its own listener without CORS rather than on the UI
+	adminMux := http.NewServeMux()
+	path, handler = observerapiconnect.NewKeyringServiceHandler(api.NewKeyringService(mesh))
+	adminMux.Handle(path, handler)
+
+	adminServer := &http.Server{
+		Addr:    cmp.Or(cfg.Server.Admin, config.DefaultAdminListen),
+		Handler: h2c.NewHandler(adminMux, &http2.Server{}),
+	}
+
+	// Bind both listeners before serving so a port that is already taken,
+	// e.g. by another instance on the same host, fails startup
+	uiListener, err := net.Listen("tcp", server.Addr)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s for the web UI and API: %w", server.Addr, err)
+	}
+
+	adminListener, err := net.Listen("tcp", adminServer.Addr)
+	if err != nil {
+		uiListener.Close()
+		return fmt.Errorf("failed to listen on %s for the admin API, set server.admin to use another address: %w", adminServer.Addr, err)
 	}
 
-	// Start HTTP server in background
+	// Start HTTP servers in background
 	go func() {
 		log.Printf("Connect-RPC API started on %s", cfg.Server.UI)
-		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		if err := server.Serve(uiListener); err != nil && err != http.ErrServerClosed {
 			log.Printf("HTTP server error: %v", err)
 		}
 	}()
 
+	go func() {
+		log.Printf("Admin API started on %s", adminServer.Addr)
+		if err := adminServer.Serve(adminListener); err != nil && err != http.ErrServerClosed {
+			log.Printf("Admin server error: %v", err)
+		}
+	}()
+
 	// Wait for interrupt signal
 	sigCh := make(chan os.Signal, 1)
 	signal.Notify(sigCh, os.Interrupt, syscall.SIGTERM)
@@ -119,6 +309,17 @@ func runServer(cmd *cobra.Command, args []string) error {
 	if err := server.Shutdown(shutdownCtx); err != nil {
 		log.Printf("HTTP server shutdown error: %v", err)
 	}
+	if err := adminServer.Shutdown(shutdownCtx); err != nil {
+		log.Printf("Admin server shutdown error: %v", err)
+	}
+
+	stopBackground()
+
+	if dnsServer != nil {
+		if err := dnsServer.Stop(); err != nil {
+			log.Printf("DNS server shutdown error: %v", err)
+		}
+	}
 
 	// Stop Serf mesh
 	if err := mesh.Stop(); err != nil {
@@ -130,23 +331,297 @@ func runServer(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
-// parseMeshConfig parses the mesh listen address
-func parseMeshConfig(listen string) (serf.MeshConfig, error) {
-	// For simplicity, parse host:port
-	// TODO: Could enhance this to support more complex configurations
-	return serf.MeshConfig{
+// parseMeshConfig builds the mesh configuration from server.listen and the
+// optional mesh block. Settings in the mesh block take precedence.
+func parseMeshConfig(cfg *config.Config) (serf.MeshConfig, error) {
+	host, port, err := config.SplitHostPort(cfg.Server.Listen)
+	if err != nil {
+		return serf.MeshConfig{}, fmt.Errorf("invalid listen address %q: %w", cfg.Server.Listen, err)
+	}
+
+	if host == "" {
+		host = "0.0.0.0"
+	}
+
+	meshConfig := serf.MeshConfig{
 		NodeName: "lattice",
-		BindAddr: "0.0.0.0",
-		BindPort: 7946, // Default port, could parse from listen address
-	}, nil
+		BindAddr: host,
+		BindPort: port,
+	}
+
+	m := cfg.Mesh
+	if m == nil {
+		return meshConfig, nil
+	}
+
+	if m.NodeName != "" {
+		meshConfig.NodeName = m.NodeName
+	}
+	if m.BindAddr != "" {
+		meshConfig.BindAddr = m.BindAddr
+	}
+	if m.BindPort != 0 {
+		meshConfig.BindPort = m.BindPort
+	}
+
+	meshConfig.AdvertiseAddr = m.AdvertiseAddr
+	meshConfig.AdvertisePort = m.AdvertisePort
+	meshConfig.JoinAddrs = m.Join
+	meshConfig.RetryJoinMaxAttempts = m.RetryJoinMaxAttempts
+	meshConfig.Tags = m.Tags
+	meshConfig.KeyringFile = m.KeyringFile
+
+	if m.TopologyTTL != "" {
+		ttl, err := time.ParseDuration(m.TopologyTTL)
+		if err != nil {
+			return serf.MeshConfig{}, fmt.Errorf("invalid topology_ttl %q: %w", m.TopologyTTL, err)
+		}
+		meshConfig.TopologyTTL = ttl
+	}
+
+	routing, err := serf.ParseRoutingMode(m.Routing)
+	if err != nil {
+		return serf.MeshConfig{}, err
+	}
+	meshConfig.Routing = routing
+
+	if m.Encrypt != "" {
+		key, err := serf.DecodeKey(m.Encrypt)
+		if err != nil {
+			return serf.MeshConfig{}, fmt.Errorf("invalid encrypt key: %w", err)
+		}
+		meshConfig.EncryptKey = key
+	}
+
+	if m.RetryJoinInterval != "" {
+		interval, err := time.ParseDuration(m.RetryJoinInterval)
+		if err != nil {
+			return serf.MeshConfig{}, fmt.Errorf("invalid retry_join_interval %q: %w", m.RetryJoinInterval, err)
+		}
+		meshConfig.RetryJoinInterval = interval
+	}
+
+	return meshConfig, nil
+}
+
+// parseCORSOptions builds the CORS middleware options from the cors block
+func parseCORSOptions(c *config.CORSConfig) (web.CORSOptions, error) {
+	opts := web.CORSOptions{
+		AllowedOrigins:   c.AllowedOrigins,
+		AllowedMethods:   c.AllowedMethods,
+		AllowedHeaders:   c.AllowedHeaders,
+		AllowCredentials: c.AllowCredentials,
+	}
+
+	if c.MaxAge != "" {
+		maxAge, err := time.ParseDuration(c.MaxAge)
+		if err != nil {
+			return web.CORSOptions{}, fmt.Errorf("invalid max_age %q: %w", c.MaxAge, err)
+		}
+		opts.MaxAge = maxAge
+	}
+
+	return opts, nil
+}
+
+const (
+	// defaultLogStoreSizeMB is the log store size limit when max_size_mb is unset
+	defaultLogStoreSizeMB = 256
+
+	// defaultHistorySizeMB is the topology history size limit when
+	// max_size_mb is unset
+	defaultHistorySizeMB = 64
+
+	// defaultLogCollectInterval is how often request logs are collected when
+	// collect_interval is unset
+	defaultLogCollectInterval = 5 * time.Second
+)
+
+// parseLogStoreOptions builds the log store options and collection interval
+// from the log_store block
+func parseLogStoreOptions(l *config.LogStoreConfig) (logstore.Options, time.Duration, error) {
+	opts := logstore.Options{
+		Dir:      l.Path,
+		MaxBytes: int64(defaultLogStoreSizeMB) << 20,
+	}
+	if l.MaxSizeMB > 0 {
+		opts.MaxBytes = int64(l.MaxSizeMB) << 20
+	}
+
+	if l.MaxAge != "" {
+		maxAge, err := time.ParseDuration(l.MaxAge)
+		if err != nil {
+			return logstore.Options{}, 0, fmt.Errorf("invalid max_age %q: %w", l.MaxAge, err)
+		}
+		opts.MaxAge = maxAge
+	}
+
+	interval := defaultLogCollectInterval
+	if l.CollectInterval != "" {
+		d, err := time.ParseDuration(l.CollectInterval)
+		if err != nil {
+			return logstore.Options{}, 0, fmt.Errorf("invalid collect_interval %q: %w", l.CollectInterval, err)
+		}
+		interval = d
+	}
+
+	return opts, interval, nil
+}
+
+// parseTopologyHistoryOptions builds the topology history options from the
+// topology_history block
+func parseTopologyHistoryOptions(h *config.TopologyHistoryConfig) (history.Options, error) {
+	opts := history.Options{
+		Dir:      h.Path,
+		MaxBytes: int64(defaultHistorySizeMB) << 20,
+	}
+	if h.MaxSizeMB > 0 {
+		opts.MaxBytes = int64(h.MaxSizeMB) << 20
+	}
+
+	if h.MaxAge != "" {
+		maxAge, err := time.ParseDuration(h.MaxAge)
+		if err != nil {
+			return history.Options{}, fmt.Errorf("invalid max_age %q: %w", h.MaxAge, err)
+		}
+		opts.MaxAge = maxAge
+	}
+
+	return opts, nil
+}
+
+// parseMetricsOptions builds the metrics recorder options, summary window
+// and collection interval from the metrics block
+func parseMetricsOptions(m *config.MetricsConfig) (metrics.Options, time.Duration, time.Duration, error) {
+	opts := metrics.Options{
+		Windows:   metrics.DefaultWindows,
+		MaxRoutes: m.MaxRoutes,
+	}
+
+	if len(m.Windows) > 0 {
+		opts.Windows = nil
+		for _, value := range m.Windows {
+			window, err := time.ParseDuration(value)
+			if err != nil {
+				return metrics.Options{}, 0, 0, fmt.Errorf("invalid window %q: %w", value, err)
+			}
+			opts.Windows = append(opts.Windows, window)
+		}
+	}
+
+	// Default to the shortest window so the summary reacts quickly
+	summaryWindow := slices.Min(opts.Windows)
+	if m.SummaryWindow != "" {
+		d, err := time.ParseDuration(m.SummaryWindow)
+		if err != nil {
+			return metrics.Options{}, 0, 0, fmt.Errorf("invalid summary_window %q: %w", m.SummaryWindow, err)
+		}
+		summaryWindow = d
+	}
+
+	interval := defaultLogCollectInterval
+	if m.CollectInterval != "" {
+		d, err := time.ParseDuration(m.CollectInterval)
+		if err != nil {
+			return metrics.Options{}, 0, 0, fmt.Errorf("invalid collect_interval %q: %w", m.CollectInterval, err)
+		}
+		interval = d
+	}
+
+	return opts, summaryWindow, interval, nil
+}
+
+// parsePrometheusSDOptions builds the service discovery options from the
+// prometheus_sd block
+func parsePrometheusSDOptions(p *config.PrometheusSDConfig) (api.PrometheusSDOptions, error) {
+	opts := api.PrometheusSDOptions{
+		Types: p.Types,
+		Tags:  p.Tags,
+		Port:  p.Port,
+	}
+
+	if len(p.PortMap) > 0 {
+		opts.PortMap = make(map[int]int, len(p.PortMap))
+		for from, to := range p.PortMap {
+			port, err := strconv.Atoi(from)
+			if err != nil {
+				return api.PrometheusSDOptions{}, fmt.Errorf("invalid port_map port %q: %w", from, err)
+			}
+			opts.PortMap[port] = to
+		}
+	}
+
+	return opts, nil
 }
 
-// newCORSInterceptor creates a CORS interceptor for browser requests
-func newCORSInterceptor() connect.UnaryInterceptorFunc {
-	interceptor := func(next connect.UnaryFunc) connect.UnaryFunc {
-		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
-			return next(ctx, req)
+// parseDNSOptions builds the DNS server options from the dns block
+func parseDNSOptions(d *config.DNSConfig) (meshdns.Options, error) {
+	opts := meshdns.Options{
+		Addr:   cmp.Or(d.Listen, config.DefaultDNSListen),
+		Domain: d.Domain,
+		TTL:    meshdns.DefaultTTL,
+	}
+
+	if d.TTL != "" {
+		ttl, err := time.ParseDuration(d.TTL)
+		if err != nil {
+			return meshdns.Options{}, fmt.Errorf("invalid ttl %q: %w", d.TTL, err)
 		}
+		opts.TTL = ttl
 	}
-	return interceptor
+
+	return opts, nil
+}
+
+// parseHealthChecks builds the active health checks from the health_check
+// blocks, keyed by service name
+func parseHealthChecks(blocks []*config.HealthCheckConfig) (map[string]health.Check, error) {
+	checks := make(map[string]health.Check, len(blocks))
+	for _, b := range blocks {
+		check := health.Check{
+			Type:               b.Type,
+			Path:               b.Path,
+			HealthyThreshold:   b.HealthyThreshold,
+			UnhealthyThreshold: b.UnhealthyThreshold,
+		}
+
+		if b.Interval != "" {
+			d, err := time.ParseDuration(b.Interval)
+			if err != nil {
+				return nil, fmt.Errorf("invalid interval %q for %q: %w", b.Interval, b.Service, err)
+			}
+			check.Interval = d
+		}
+
+		if b.Timeout != "" {
+			d, err := time.ParseDuration(b.Timeout)
+			if err != nil {
+				return nil, fmt.Errorf("invalid timeout %q for %q: %w", b.Timeout, b.Service, err)
+			}
+			check.Timeout = d
+		}
+
+		checks[b.Service] = check
+	}
+	return checks, nil
+}
+
+// parsePassiveHealth builds the passive health options from the
+// passive_health block
+func parsePassiveHealth(p *config.PassiveHealthConfig) (health.Passive, error) {
+	passive := health.Passive{
+		MinRequests:        int64(p.MinRequests),
+		DegradedErrorRate:  p.DegradedErrorRate,
+		UnhealthyErrorRate: p.UnhealthyErrorRate,
+	}
+
+	if p.Window != "" {
+		d, err := time.ParseDuration(p.Window)
+		if err != nil {
+			return health.Passive{}, fmt.Errorf("invalid window %q: %w", p.Window, err)
+		}
+		passive.Window = d
+	}
+
+	return passive.WithDefaults(), nil
 }
@@ -0,0 +1,566 @@
+package cli
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"connectrpc.com/connect"
+	observerv1 "github.com/jumppad-labs/lattice/pkg/api/observer/v1"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+var topologyCmd = &cobra.Command{
+	Use:   "topology",
+	Short: "Print the service graph",
+	Long: `Print the services in the mesh and the upstreams they depend on, as an ASCII
+tree, a Graphviz DOT graph, a Mermaid flowchart or JSON.
+
+With --group-by-node, services are grouped under the node they run on, and
+the links between nodes from the mesh connectivity graph are shown too.
+
+Examples:
+  # Dependency tree of the whole mesh
+  lattice topology
+
+  # Render with Graphviz
+  lattice topology --format dot | dot -Tsvg > mesh.svg
+
+  # Everything within two hops of the orders service, for an incident ticket
+  lattice topology --service orders --depth 2 --format mermaid`,
+	Args: cobra.NoArgs,
+	RunE: runTopology,
+}
+
+var topologyFlags struct {
+	format      string
+	groupByNode bool
+	service     string
+	depth       int
+}
+
+func init() {
+	topologyCmd.Flags().StringVar(&topologyFlags.format, "format", "tree", "output format: tree, dot, mermaid or json")
+	topologyCmd.Flags().BoolVar(&topologyFlags.groupByNode, "group-by-node", false, "group services by the node they run on")
+	topologyCmd.Flags().StringVar(&topologyFlags.service, "service", "", "only show the neighborhood of this service")
+	topologyCmd.Flags().IntVar(&topologyFlags.depth, "depth", 1, "hops from --service to include")
+	rootCmd.AddCommand(topologyCmd)
+}
+
+func runTopology(cmd *cobra.Command, args []string) error {
+	switch topologyFlags.format {
+	case "tree", "dot", "mermaid", "json":
+	default:
+		return fmt.Errorf("invalid format %q: must be tree, dot, mermaid or json", topologyFlags.format)
+	}
+	if topologyFlags.depth < 0 {
+		return errors.New("--depth must not be negative")
+	}
+
+	resp, err := newObserverClient().GetTopology(cmd.Context(), connect.NewRequest(&observerv1.GetTopologyRequest{
+		IncludeGraph: true,
+	}))
+	if err != nil {
+		return err
+	}
+
+	services := slices.Clone(resp.Msg.Topology.GetServices())
+	slices.SortFunc(services, func(a, b *observerv1.Service) int {
+		return cmp.Or(strings.Compare(a.Name, b.Name), strings.Compare(a.NodeName, b.NodeName))
+	})
+	links := resp.Msg.Graph
+
+	// Upstreams outside the neighborhood are left out of the graph
+	var within map[string]bool
+	if topologyFlags.service != "" {
+		services, links, within = neighborhood(services, links, topologyFlags.service, topologyFlags.depth)
+		if len(services) == 0 {
+			return fmt.Errorf("service %q not found", topologyFlags.service)
+		}
+	}
+
+	w := cmd.OutOrStdout()
+	if topologyFlags.format == "json" {
+		out, err := protojson.MarshalOptions{Multiline: true}.Marshal(&observerv1.GetTopologyResponse{
+			Topology: &observerv1.Topology{Services: services, Timestamp: resp.Msg.Topology.GetTimestamp()},
+			Graph:    links,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to encode topology: %w", err)
+		}
+		fmt.Fprintln(w, string(out))
+		return nil
+	}
+
+	g := newServiceGraph(services, links, within, topologyFlags.groupByNode)
+	switch topologyFlags.format {
+	case "dot":
+		g.writeDOT(w)
+	case "mermaid":
+		g.writeMermaid(w)
+	default:
+		g.writeTree(w)
+	}
+	return nil
+}
+
+// neighborhood returns the services within depth hops of a service, following
+// upstream edges in both directions, the mesh links between their nodes, and
+// the names of all services within reach, including upstreams not in the mesh
+func neighborhood(services []*observerv1.Service, links []*observerv1.GraphNode, name string, depth int) ([]*observerv1.Service, []*observerv1.GraphNode, map[string]bool) {
+	adjacent := make(map[string][]string)
+	for _, svc := range services {
+		for _, up := range svc.Upstreams {
+			adjacent[svc.Name] = append(adjacent[svc.Name], up)
+			adjacent[up] = append(adjacent[up], svc.Name)
+		}
+	}
+
+	hops := map[string]int{name: 0}
+	queue := []string{name}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		if hops[current] == depth {
+			continue
+		}
+		for _, next := range adjacent[current] {
+			if _, ok := hops[next]; !ok {
+				hops[next] = hops[current] + 1
+				queue = append(queue, next)
+			}
+		}
+	}
+
+	within := make(map[string]bool, len(hops))
+	for n := range hops {
+		within[n] = true
+	}
+
+	var kept []*observerv1.Service
+	nodes := make(map[string]bool)
+	for _, svc := range services {
+		if within[svc.Name] {
+			kept = append(kept, svc)
+			nodes[svc.NodeName] = true
+		}
+	}
+
+	var keptLinks []*observerv1.GraphNode
+	for _, link := range links {
+		if !nodes[link.Node] {
+			continue
+		}
+		var neighbors []string
+		for _, n := range link.Neighbors {
+			if nodes[n] {
+				neighbors = append(neighbors, n)
+			}
+		}
+		keptLinks = append(keptLinks, &observerv1.GraphNode{Node: link.Node, Neighbors: neighbors})
+	}
+
+	return kept, keptLinks, within
+}
+
+// serviceGraph is a topology prepared for rendering. Vertices are services,
+// or service instances when grouped by node, and edges point from a service
+// to its upstreams.
+type serviceGraph struct {
+	grouped  bool
+	vertices []*vertex // Sorted by node, then name
+	byID     map[string]*vertex
+	edges    map[string][]string // Vertex ID -> upstream vertex IDs, sorted
+	nodes    []string            // Nodes, when grouped
+	links    map[string][]string // Node -> linked nodes, when grouped
+}
+
+// vertex is a service, or one instance of it when grouped by node
+type vertex struct {
+	id       string
+	name     string
+	node     string // Empty when not grouped, or for unknown upstreams
+	kind     string
+	statuses []string
+	missing  bool // An upstream with no instances in the mesh
+}
+
+// label returns the service name with its type
+func (v *vertex) label() string {
+	if v.kind == "" {
+		return v.name
+	}
+	return v.name + " (" + v.kind + ")"
+}
+
+// status returns the vertex status, joining the statuses of instances that
+// disagree
+func (v *vertex) status() string {
+	return strings.Join(v.statuses, "/")
+}
+
+// newServiceGraph prepares services for rendering. If within is set, only
+// upstreams named in it are included.
+func newServiceGraph(services []*observerv1.Service, links []*observerv1.GraphNode, within map[string]bool, grouped bool) *serviceGraph {
+	g := &serviceGraph{
+		grouped: grouped,
+		byID:    make(map[string]*vertex),
+		edges:   make(map[string][]string),
+		links:   make(map[string][]string),
+	}
+
+	instances := make(map[string][]string) // Service name -> vertex IDs
+	for _, svc := range services {
+		id, node := svc.Name, ""
+		if grouped {
+			id, node = svc.NodeName+"/"+svc.Name, svc.NodeName
+		}
+
+		v := g.vertex(id, svc.Name, node)
+		if v.kind == "" {
+			v.kind = svc.Type
+		}
+		if status := statusName(svc.Status); !slices.Contains(v.statuses, status) {
+			v.statuses = append(v.statuses, status)
+		}
+		if !slices.Contains(instances[svc.Name], id) {
+			instances[svc.Name] = append(instances[svc.Name], id)
+		}
+	}
+
+	for _, svc := range services {
+		from := svc.Name
+		if grouped {
+			from = svc.NodeName + "/" + svc.Name
+		}
+		for _, up := range svc.Upstreams {
+			if within != nil && !within[up] {
+				continue
+			}
+			targets := instances[up]
+			if len(targets) == 0 {
+				// An upstream with no instances in the mesh
+				g.vertex(up, up, "").missing = true
+				targets = []string{up}
+			}
+			for _, to := range targets {
+				if !slices.Contains(g.edges[from], to) {
+					g.edges[from] = append(g.edges[from], to)
+				}
+			}
+		}
+	}
+	for id := range g.edges {
+		slices.Sort(g.edges[id])
+	}
+
+	if grouped {
+		for _, v := range g.vertices {
+			if v.node != "" && !slices.Contains(g.nodes, v.node) {
+				g.nodes = append(g.nodes, v.node)
+			}
+		}
+		for _, link := range links {
+			if !slices.Contains(g.nodes, link.Node) {
+				g.nodes = append(g.nodes, link.Node)
+			}
+			g.links[link.Node] = link.Neighbors
+		}
+		slices.Sort(g.nodes)
+	}
+
+	slices.SortFunc(g.vertices, func(a, b *vertex) int {
+		return cmp.Or(strings.Compare(a.node, b.node), strings.Compare(a.name, b.name))
+	})
+
+	return g
+}
+
+// vertex returns the vertex with an ID, adding it if needed
+func (g *serviceGraph) vertex(id, name, node string) *vertex {
+	if v, ok := g.byID[id]; ok {
+		return v
+	}
+	v := &vertex{id: id, name: name, node: node}
+	g.vertices = append(g.vertices, v)
+	g.byID[id] = v
+	return v
+}
+
+// nodeVertices returns the vertices of the services on a node
+func (g *serviceGraph) nodeVertices(node string) []*vertex {
+	var vertices []*vertex
+	for _, v := range g.vertices {
+		if v.node == node {
+			vertices = append(vertices, v)
+		}
+	}
+	return vertices
+}
+
+// nodeLinks returns each link between nodes once
+func (g *serviceGraph) nodeLinks() [][2]string {
+	var links [][2]string
+	for _, node := range g.nodes {
+		for _, neighbor := range g.links[node] {
+			link := [2]string{min(node, neighbor), max(node, neighbor)}
+			if !slices.Contains(links, link) {
+				links = append(links, link)
+			}
+		}
+	}
+	return links
+}
+
+// writeTree writes the graph as an ASCII tree. Ungrouped, each service is a
+// root if nothing depends on it, with its upstreams nested below it. Grouped,
+// each node lists its services and their upstreams.
+func (g *serviceGraph) writeTree(w io.Writer) {
+	if len(g.vertices) == 0 && len(g.nodes) == 0 {
+		fmt.Fprintln(w, "No services")
+		return
+	}
+
+	if g.grouped {
+		for _, node := range g.nodes {
+			line := node
+			if len(g.links[node]) > 0 {
+				line += " (linked to " + strings.Join(g.links[node], ", ") + ")"
+			}
+			fmt.Fprintln(w, line)
+
+			vertices := g.nodeVertices(node)
+			for i, v := range vertices {
+				branch := "├── "
+				if i == len(vertices)-1 {
+					branch = "└── "
+				}
+				line := branch + v.label() + " " + v.status()
+				if ups := g.edges[v.id]; len(ups) > 0 {
+					line += " -> " + strings.Join(ups, ", ")
+				}
+				fmt.Fprintln(w, line)
+			}
+		}
+
+		// Upstreams that aren't running anywhere
+		for _, v := range g.nodeVertices("") {
+			fmt.Fprintf(w, "%s (not in the mesh)\n", v.name)
+		}
+		return
+	}
+
+	dependedOn := make(map[string]bool)
+	for _, ups := range g.edges {
+		for _, up := range ups {
+			dependedOn[up] = true
+		}
+	}
+
+	printed := make(map[string]bool)
+	for _, v := range g.vertices {
+		if !dependedOn[v.id] {
+			g.writeSubtree(w, v.id, "", "", nil, printed)
+		}
+	}
+	// Services that only appear in cycles have no root
+	for _, v := range g.vertices {
+		if !printed[v.id] {
+			g.writeSubtree(w, v.id, "", "", nil, printed)
+		}
+	}
+}
+
+// writeSubtree writes a vertex and, recursively, its upstreams. path holds
+// the vertices above it, so cycles are cut short.
+func (g *serviceGraph) writeSubtree(w io.Writer, id, prefix, branch string, path []string, printed map[string]bool) {
+	v := g.byID[id]
+	line := prefix + branch + v.label()
+	if status := v.status(); status != "" {
+		line += " " + status
+	}
+	if v.missing {
+		line += " (not in the mesh)"
+	}
+	if slices.Contains(path, id) {
+		fmt.Fprintln(w, line+" (cycle)")
+		return
+	}
+	fmt.Fprintln(w, line)
+	printed[id] = true
+
+	switch branch {
+	case "├── ":
+		prefix += "│   "
+	case "└── ":
+		prefix += "    "
+	}
+
+	path = append(path, id)
+	ups := g.edges[id]
+	for i, up := range ups {
+		next := "├── "
+		if i == len(ups)-1 {
+			next = "└── "
+		}
+		g.writeSubtree(w, up, prefix, next, path, printed)
+	}
+}
+
+// dotColors outline services by status in DOT output
+var dotColors = map[string]string{
+	"HEALTHY":   "green4",
+	"DEGRADED":  "orange",
+	"UNHEALTHY": "red",
+}
+
+// writeDOT writes the graph in Graphviz DOT format. Grouped, each node is a
+// cluster and mesh links are dashed edges between clusters.
+func (g *serviceGraph) writeDOT(w io.Writer) {
+	fmt.Fprintln(w, "digraph lattice {")
+	fmt.Fprintln(w, "  rankdir=LR;")
+	if g.grouped {
+		fmt.Fprintln(w, "  compound=true;")
+	}
+	fmt.Fprintln(w, "  node [shape=box, style=rounded];")
+	fmt.Fprintln(w)
+
+	writeVertex := func(indent string, v *vertex) {
+		label := dotEscape(v.name)
+		if v.kind != "" {
+			label += `\n` + dotEscape(v.kind)
+		}
+		attrs := `label="` + label + `"`
+		if color, ok := dotColors[v.status()]; ok {
+			attrs += ", color=" + color
+		}
+		if v.missing {
+			attrs += `, style="rounded,dashed"`
+		}
+		fmt.Fprintf(w, "%s%s [%s];\n", indent, dotQuote(v.id), attrs)
+	}
+
+	if g.grouped {
+		for _, node := range g.nodes {
+			fmt.Fprintf(w, "  subgraph %s {\n", dotQuote("cluster_"+node))
+			fmt.Fprintf(w, "    label=%s;\n", dotQuote(node))
+			// Anchors the mesh links, which end at the cluster border
+			fmt.Fprintf(w, "    %s [shape=point, style=invis];\n", dotQuote("node:"+node))
+			for _, v := range g.nodeVertices(node) {
+				writeVertex("    ", v)
+			}
+			fmt.Fprintln(w, "  }")
+		}
+		for _, v := range g.nodeVertices("") {
+			writeVertex("  ", v)
+		}
+	} else {
+		for _, v := range g.vertices {
+			writeVertex("  ", v)
+		}
+	}
+
+	for _, v := range g.vertices {
+		for _, up := range g.edges[v.id] {
+			fmt.Fprintf(w, "  %s -> %s;\n", dotQuote(v.id), dotQuote(up))
+		}
+	}
+
+	if g.grouped {
+		for _, link := range g.nodeLinks() {
+			fmt.Fprintf(w, "  %s -> %s [dir=none, style=dashed, ltail=%s, lhead=%s];\n",
+				dotQuote("node:"+link[0]), dotQuote("node:"+link[1]),
+				dotQuote("cluster_"+link[0]), dotQuote("cluster_"+link[1]))
+		}
+	}
+
+	fmt.Fprintln(w, "}")
+}
+
+// dotEscape escapes a string for use inside a quoted DOT ID
+func dotEscape(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s)
+}
+
+// dotQuote quotes a string as a DOT ID
+func dotQuote(s string) string {
+	return `"` + dotEscape(s) + `"`
+}
+
+// mermaidClasses style services by status in Mermaid output
+var mermaidClasses = map[string]string{
+	"HEALTHY":   "healthy",
+	"DEGRADED":  "degraded",
+	"UNHEALTHY": "unhealthy",
+}
+
+// writeMermaid writes the graph as a Mermaid flowchart. Grouped, each node is
+// a subgraph and mesh links are dotted lines between subgraphs.
+func (g *serviceGraph) writeMermaid(w io.Writer) {
+	// Mermaid IDs can't hold arbitrary characters, so number them
+	ids := make(map[string]string, len(g.vertices))
+	for i, v := range g.vertices {
+		ids[v.id] = fmt.Sprintf("s%d", i)
+	}
+	nodeIDs := make(map[string]string, len(g.nodes))
+	for i, node := range g.nodes {
+		nodeIDs[node] = fmt.Sprintf("n%d", i)
+	}
+
+	fmt.Fprintln(w, "flowchart LR")
+
+	writeVertex := func(indent string, v *vertex) {
+		label := mermaidEscape(v.name)
+		if v.kind != "" {
+			label += "<br/>" + mermaidEscape(v.kind)
+		}
+		fmt.Fprintf(w, "%s%s[\"%s\"]\n", indent, ids[v.id], label)
+	}
+
+	if g.grouped {
+		for _, node := range g.nodes {
+			fmt.Fprintf(w, "  subgraph %s[\"%s\"]\n", nodeIDs[node], mermaidEscape(node))
+			for _, v := range g.nodeVertices(node) {
+				writeVertex("    ", v)
+			}
+			fmt.Fprintln(w, "  end")
+		}
+		for _, v := range g.nodeVertices("") {
+			writeVertex("  ", v)
+		}
+	} else {
+		for _, v := range g.vertices {
+			writeVertex("  ", v)
+		}
+	}
+
+	for _, v := range g.vertices {
+		for _, up := range g.edges[v.id] {
+			fmt.Fprintf(w, "  %s --> %s\n", ids[v.id], ids[up])
+		}
+	}
+
+	if g.grouped {
+		for _, link := range g.nodeLinks() {
+			fmt.Fprintf(w, "  %s -.- %s\n", nodeIDs[link[0]], nodeIDs[link[1]])
+		}
+	}
+
+	fmt.Fprintln(w, "  classDef healthy stroke:#16a34a")
+	fmt.Fprintln(w, "  classDef degraded stroke:#f59e0b")
+	fmt.Fprintln(w, "  classDef unhealthy stroke:#dc2626")
+	for _, v := range g.vertices {
+		if class, ok := mermaidClasses[v.status()]; ok {
+			fmt.Fprintf(w, "  class %s %s\n", ids[v.id], class)
+		}
+	}
+}
+
+// mermaidEscape escapes a string for use in a quoted Mermaid label
+func mermaidEscape(s string) string {
+	return strings.NewReplacer(`"`, "#quot;", "<", "#lt;", ">", "#gt;").Replace(s)
+}
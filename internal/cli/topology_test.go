@@ -0,0 +1,300 @@
+package cli
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	observerv1 "github.com/jumppad-labs/lattice/pkg/api/observer/v1"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	healthy   = observerv1.ServiceStatus_SERVICE_STATUS_HEALTHY
+	degraded  = observerv1.ServiceStatus_SERVICE_STATUS_DEGRADED
+	unhealthy = observerv1.ServiceStatus_SERVICE_STATUS_UNHEALTHY
+)
+
+// testTopology has two gateway instances on different nodes, a cycle
+// between orders and payments, and billing, which has no instances
+func testTopology() ([]*observerv1.Service, []*observerv1.GraphNode) {
+	services := []*observerv1.Service{
+		{Name: "gateway", Type: "http", NodeName: "node1", Status: healthy, Upstreams: []string{"users", "orders"}},
+		{Name: "gateway", Type: "http", NodeName: "node2", Status: degraded, Upstreams: []string{"users", "orders"}},
+		{Name: "orders", Type: "grpc", NodeName: "node2", Status: degraded, Upstreams: []string{"payments", "users"}},
+		{Name: "payments", Type: "grpc", NodeName: "node2", Status: unhealthy, Upstreams: []string{"orders"}},
+		{Name: "users", Type: "http", NodeName: "node1", Status: healthy, Upstreams: []string{"billing"}},
+	}
+	links := []*observerv1.GraphNode{
+		{Node: "lattice", Neighbors: []string{"node1"}},
+		{Node: "node1", Neighbors: []string{"lattice", "node2"}},
+		{Node: "node2", Neighbors: []string{"node1"}},
+	}
+	return services, links
+}
+
+// render renders a graph with one of its writers
+func render(write func(io.Writer)) string {
+	var out bytes.Buffer
+	write(&out)
+	return out.String()
+}
+
+func TestNeighborhood(t *testing.T) {
+	services, links := testTopology()
+
+	names := func(services []*observerv1.Service) []string {
+		var names []string
+		for _, svc := range services {
+			names = append(names, svc.NodeName+"/"+svc.Name)
+		}
+		return names
+	}
+
+	tests := []struct {
+		name     string
+		service  string
+		depth    int
+		services []string
+		within   map[string]bool
+		links    []*observerv1.GraphNode
+	}{
+		{
+			name:     "depth 0",
+			service:  "payments",
+			depth:    0,
+			services: []string{"node2/payments"},
+			within:   map[string]bool{"payments": true},
+			links:    []*observerv1.GraphNode{{Node: "node2"}},
+		},
+		{
+			name:     "depth 1",
+			service:  "payments",
+			depth:    1,
+			services: []string{"node2/orders", "node2/payments"},
+			within:   map[string]bool{"payments": true, "orders": true},
+			links:    []*observerv1.GraphNode{{Node: "node2"}},
+		},
+		{
+			name:     "depth 2",
+			service:  "payments",
+			depth:    2,
+			services: []string{"node1/gateway", "node2/gateway", "node2/orders", "node2/payments", "node1/users"},
+			within:   map[string]bool{"payments": true, "orders": true, "gateway": true, "users": true},
+			links: []*observerv1.GraphNode{
+				{Node: "node1", Neighbors: []string{"node2"}},
+				{Node: "node2", Neighbors: []string{"node1"}},
+			},
+		},
+		{
+			name:     "missing upstream",
+			service:  "users",
+			depth:    1,
+			services: []string{"node1/gateway", "node2/gateway", "node2/orders", "node1/users"},
+			within:   map[string]bool{"users": true, "gateway": true, "orders": true, "billing": true},
+			links: []*observerv1.GraphNode{
+				{Node: "node1", Neighbors: []string{"node2"}},
+				{Node: "node2", Neighbors: []string{"node1"}},
+			},
+		},
+		{
+			name:    "unknown service",
+			service: "missing",
+			depth:   3,
+			within:  map[string]bool{"missing": true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kept, keptLinks, within := neighborhood(services, links, tt.service, tt.depth)
+			require.Equal(t, tt.services, names(kept))
+			require.Equal(t, tt.within, within)
+			require.Equal(t, len(tt.links), len(keptLinks))
+			for i, link := range tt.links {
+				require.Equal(t, link.Node, keptLinks[i].Node)
+				require.Equal(t, link.Neighbors, keptLinks[i].Neighbors)
+			}
+		})
+	}
+}
+
+func TestWriteTree(t *testing.T) {
+	services, links := testTopology()
+
+	g := newServiceGraph(services, links, nil, false)
+	require.Equal(t, `gateway (http) HEALTHY/DEGRADED
+├── orders (grpc) DEGRADED
+│   ├── payments (grpc) UNHEALTHY
+│   │   └── orders (grpc) DEGRADED (cycle)
+│   └── users (http) HEALTHY
+│       └── billing (not in the mesh)
+└── users (http) HEALTHY
+    └── billing (not in the mesh)
+`, render(g.writeTree))
+
+	g = newServiceGraph(services, links, nil, true)
+	require.Equal(t, `lattice (linked to node1)
+node1 (linked to lattice, node2)
+├── gateway (http) HEALTHY -> node1/users, node2/orders
+└── users (http) HEALTHY -> billing
+node2 (linked to node1)
+├── gateway (http) DEGRADED -> node1/users, node2/orders
+├── orders (grpc) DEGRADED -> node1/users, node2/payments
+└── payments (grpc) UNHEALTHY -> node2/orders
+billing (not in the mesh)
+`, render(g.writeTree))
+
+	require.Equal(t, "No services\n", render(newServiceGraph(nil, nil, nil, false).writeTree))
+}
+
+func TestWriteTreeDepth(t *testing.T) {
+	services, links := testTopology()
+
+	// Upstreams beyond the depth limit are left out, not shown as missing
+	kept, keptLinks, within := neighborhood(services, links, "users", 1)
+	g := newServiceGraph(kept, keptLinks, within, false)
+	require.Equal(t, `gateway (http) HEALTHY/DEGRADED
+├── orders (grpc) DEGRADED
+│   └── users (http) HEALTHY
+│       └── billing (not in the mesh)
+└── users (http) HEALTHY
+    └── billing (not in the mesh)
+`, render(g.writeTree))
+
+	kept, keptLinks, within = neighborhood(services, links, "payments", 0)
+	g = newServiceGraph(kept, keptLinks, within, false)
+	require.Equal(t, "payments (grpc) UNHEALTHY\n", render(g.writeTree))
+}
+
+func TestWriteTreeCycleWithoutRoot(t *testing.T) {
+	services := []*observerv1.Service{
+		{Name: "a", NodeName: "node1", Status: healthy, Upstreams: []string{"b"}},
+		{Name: "b", NodeName: "node1", Status: healthy, Upstreams: []string{"a"}},
+	}
+
+	g := newServiceGraph(services, nil, nil, false)
+	require.Equal(t, `a HEALTHY
+└── b HEALTHY
+    └── a HEALTHY (cycle)
+`, render(g.writeTree))
+}
+
+func TestWriteDOT(t *testing.T) {
+	services, links := testTopology()
+
+	g := newServiceGraph(services, links, nil, false)
+	require.Equal(t, `digraph lattice {
+  rankdir=LR;
+  node [shape=box, style=rounded];
+
+  "billing" [label="billing", style="rounded,dashed"];
+  "gateway" [label="gateway\nhttp"];
+  "orders" [label="orders\ngrpc", color=orange];
+  "payments" [label="payments\ngrpc", color=red];
+  "users" [label="users\nhttp", color=green4];
+  "gateway" -> "orders";
+  "gateway" -> "users";
+  "orders" -> "payments";
+  "orders" -> "users";
+  "payments" -> "orders";
+  "users" -> "billing";
+}
+`, render(g.writeDOT))
+
+	g = newServiceGraph(services, links, nil, true)
+	require.Equal(t, `digraph lattice {
+  rankdir=LR;
+  compound=true;
+  node [shape=box, style=rounded];
+
+  subgraph "cluster_lattice" {
+    label="lattice";
+    "node:lattice" [shape=point, style=invis];
+  }
+  subgraph "cluster_node1" {
+    label="node1";
+    "node:node1" [shape=point, style=invis];
+    "node1/gateway" [label="gateway\nhttp", color=green4];
+    "node1/users" [label="users\nhttp", color=green4];
+  }
+  subgraph "cluster_node2" {
+    label="node2";
+    "node:node2" [shape=point, style=invis];
+    "node2/gateway" [label="gateway\nhttp", color=orange];
+    "node2/orders" [label="orders\ngrpc", color=orange];
+    "node2/payments" [label="payments\ngrpc", color=red];
+  }
+  "billing" [label="billing", style="rounded,dashed"];
+  "node1/gateway" -> "node1/users";
+  "node1/gateway" -> "node2/orders";
+  "node1/users" -> "billing";
+  "node2/gateway" -> "node1/users";
+  "node2/gateway" -> "node2/orders";
+  "node2/orders" -> "node1/users";
+  "node2/orders" -> "node2/payments";
+  "node2/payments" -> "node2/orders";
+  "node:lattice" -> "node:node1" [dir=none, style=dashed, ltail="cluster_lattice", lhead="cluster_node1"];
+  "node:node1" -> "node:node2" [dir=none, style=dashed, ltail="cluster_node1", lhead="cluster_node2"];
+}
+`, render(g.writeDOT))
+}
+
+func TestWriteMermaid(t *testing.T) {
+	services, links := testTopology()
+
+	g := newServiceGraph(services, links, nil, false)
+	require.Equal(t, `flowchart LR
+  s0["billing"]
+  s1["gateway<br/>http"]
+  s2["orders<br/>grpc"]
+  s3["payments<br/>grpc"]
+  s4["users<br/>http"]
+  s1 --> s2
+  s1 --> s4
+  s2 --> s3
+  s2 --> s4
+  s3 --> s2
+  s4 --> s0
+  classDef healthy stroke:#16a34a
+  classDef degraded stroke:#f59e0b
+  classDef unhealthy stroke:#dc2626
+  class s2 degraded
+  class s3 unhealthy
+  class s4 healthy
+`, render(g.writeMermaid))
+
+	g = newServiceGraph(services, links, nil, true)
+	require.Equal(t, `flowchart LR
+  subgraph n0["lattice"]
+  end
+  subgraph n1["node1"]
+    s1["gateway<br/>http"]
+    s2["users<br/>http"]
+  end
+  subgraph n2["node2"]
+    s3["gateway<br/>http"]
+    s4["orders<br/>grpc"]
+    s5["payments<br/>grpc"]
+  end
+  s0["billing"]
+  s1 --> s2
+  s1 --> s4
+  s2 --> s0
+  s3 --> s2
+  s3 --> s4
+  s4 --> s2
+  s4 --> s5
+  s5 --> s4
+  n0 -.- n1
+  n1 -.- n2
+  classDef healthy stroke:#16a34a
+  classDef degraded stroke:#f59e0b
+  classDef unhealthy stroke:#dc2626
+  class s1 healthy
+  class s2 healthy
+  class s3 degraded
+  class s4 degraded
+  class s5 unhealthy
+`, render(g.writeMermaid))
+}
@@ -0,0 +1,88 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclparse"
+	"github.com/jumppad-labs/lattice/internal/config"
+	"github.com/spf13/cobra"
+)
+
+// diagnosticWidth is the width diagnostic details are wrapped to
+const diagnosticWidth = 78
+
+var validateCmd = &cobra.Command{
+	Use:   "validate",
+	Short: "Check a configuration file for errors",
+	Long: `Check a configuration file for errors without starting the server.
+
+The file is decoded and validated the same way the server does, including
+address syntax, port conflicts between the gossip, UI, admin (server.admin)
+and DNS listeners, and encryption key formats.
+Each problem is printed with the part of the file it was found in, and the
+command exits non-zero if there are any.
+
+Examples:
+  # Check a configuration before deploying it
+  lattice validate -c lattice.hcl`,
+	Args: cobra.NoArgs,
+	RunE: runValidate,
+}
+
+var validateConfigPath string
+
+func init() {
+	validateCmd.Flags().StringVarP(&validateConfigPath, "config", "c", "", "path to configuration file (required)")
+	validateCmd.MarkFlagRequired("config")
+	rootCmd.AddCommand(validateCmd)
+}
+
+func runValidate(cmd *cobra.Command, args []string) error {
+	// Problems with the file are reported as diagnostics, usage wouldn't help
+	cmd.SilenceUsage = true
+
+	var diags hcl.Diagnostics
+	cfg, err := config.ParseFile(validateConfigPath)
+	if err == nil {
+		err = config.Validate(cfg)
+	}
+	if err != nil && !errors.As(err, &diags) {
+		return err
+	}
+
+	return reportDiagnostics(cmd, validateConfigPath, diags)
+}
+
+// reportDiagnostics prints diagnostics for a configuration file and returns
+// an error if any of them are errors. Warnings alone leave the file valid.
+func reportDiagnostics(cmd *cobra.Command, path string, diags hcl.Diagnostics) error {
+	if len(diags) > 0 {
+		if err := writeDiagnostics(cmd.ErrOrStderr(), path, diags); err != nil {
+			return err
+		}
+	}
+	if diags.HasErrors() {
+		return fmt.Errorf("%s is invalid: %d error(s)", path, len(diags.Errs()))
+	}
+
+	fmt.Fprintf(cmd.OutOrStdout(), "%s is valid\n", path)
+	return nil
+}
+
+// writeDiagnostics prints diagnostics with snippets of the configuration
+// file they refer to
+func writeDiagnostics(w io.Writer, path string, diags hcl.Diagnostics) error {
+	files := make(map[string]*hcl.File)
+	if src, err := os.ReadFile(path); err == nil {
+		// Files with syntax errors still have source to show
+		if file, _ := hclparse.NewParser().ParseHCL(src, path); file != nil {
+			files[path] = file
+		}
+	}
+
+	return hcl.NewDiagnosticTextWriter(w, files, diagnosticWidth, useColor(w)).WriteDiagnostics(diags)
+}
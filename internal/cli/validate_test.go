@@ -0,0 +1,89 @@
+package cli
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+)
+
+// runValidateFile runs the validate command on a configuration file and
+// returns its output and error output
+func runValidateFile(t *testing.T, content string) (string, string, error) {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "lattice.hcl")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+
+	old := validateConfigPath
+	validateConfigPath = path
+	t.Cleanup(func() { validateConfigPath = old })
+
+	var out, errOut bytes.Buffer
+	cmd := &cobra.Command{}
+	cmd.SetOut(&out)
+	cmd.SetErr(&errOut)
+
+	err := runValidate(cmd, nil)
+	return out.String(), errOut.String(), err
+}
+
+func TestValidateValid(t *testing.T) {
+	out, errOut, err := runValidateFile(t, `
+server {
+  listen = "0.0.0.0:7946"
+  ui     = "0.0.0.0:9000"
+}
+`)
+	require.NoError(t, err)
+	require.Contains(t, out, "lattice.hcl is valid")
+	require.Empty(t, errOut)
+}
+
+func TestValidateInvalid(t *testing.T) {
+	out, errOut, err := runValidateFile(t, `
+server {
+  listen = "0.0.0.0:7946"
+  ui     = "0.0.0.0:7946"
+}
+`)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "is invalid: 1 error(s)")
+	require.Empty(t, out)
+
+	// The diagnostic points at the file and line, with a snippet
+	require.Contains(t, errOut, "Error: Invalid server.ui")
+	require.Contains(t, errOut, "Port 7946 is already used for gossip by server.listen.")
+	require.Contains(t, errOut, "lattice.hcl line 4, in server:")
+	require.Contains(t, errOut, `4:   ui     = "0.0.0.0:7946"`)
+}
+
+func TestValidateSyntaxError(t *testing.T) {
+	_, errOut, err := runValidateFile(t, `
+server {
+  listen = 
+}
+`)
+	require.Error(t, err)
+	require.Contains(t, errOut, "lattice.hcl line 3")
+}
+
+func TestReportDiagnosticsWarnings(t *testing.T) {
+	var out, errOut bytes.Buffer
+	cmd := &cobra.Command{}
+	cmd.SetOut(&out)
+	cmd.SetErr(&errOut)
+
+	err := reportDiagnostics(cmd, "lattice.hcl", hcl.Diagnostics{{
+		Severity: hcl.DiagWarning,
+		Summary:  "Deprecated attribute",
+		Detail:   "Use something else.",
+	}})
+	require.NoError(t, err)
+	require.Contains(t, errOut.String(), "Warning: Deprecated attribute")
+	require.Contains(t, out.String(), "lattice.hcl is valid")
+}
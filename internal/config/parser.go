@@ -1,10 +1,40 @@
 package config
 
 import (
+	"cmp"
+	"errors"
 	"fmt"
+	"net"
+	"net/http"
+	"net/url"
 	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/hcl/v2"
 	"github.com/hashicorp/hcl/v2/hclsimple"
+	"github.com/jumppad-labs/lattice/internal/health"
+	"github.com/jumppad-labs/lattice/internal/metrics"
+	"github.com/jumppad-labs/lattice/internal/serf"
+	"github.com/miekg/dns"
+)
+
+const (
+	// DefaultPrometheusPath is where metrics are served when prometheus.path
+	// is unset
+	DefaultPrometheusPath = "/metrics"
+
+	// DefaultPrometheusSDPath is where service discovery targets are served
+	// when prometheus_sd.path is unset
+	DefaultPrometheusSDPath = "/prometheus/sd"
+
+	// DefaultDNSListen is the DNS server address when dns.listen is unset
+	DefaultDNSListen = "127.0.0.1:8600"
+
+	// DefaultAdminListen is the admin API address when server.admin is unset
+	DefaultAdminListen = "127.0.0.1:9001"
 )
 
 // ParseFile parses a Lattice configuration file
@@ -25,16 +55,834 @@ func ParseFile(path string) (*Config, error) {
 // Validate validates the configuration
 func Validate(cfg *Config) error {
 	if cfg.Server == nil {
-		return fmt.Errorf("server block is required")
+		return hcl.Diagnostics{errorDiag(
+			"Missing server block",
+			"A server block with the listen and ui addresses is required.",
+			bodyRange(cfg.Body),
+		)}
+	}
+
+	var diags hcl.Diagnostics
+
+	for _, attr := range []struct {
+		name  string
+		value string
+	}{
+		{"listen", cfg.Server.Listen},
+		{"ui", cfg.Server.UI},
+		{"admin", cmp.Or(cfg.Server.Admin, DefaultAdminListen)},
+	} {
+		if attr.value == "" {
+			diags = append(diags, errorDiag(
+				"Invalid server."+attr.name,
+				"The address must not be empty.",
+				attrRange(cfg.Server.Body, attr.name),
+			))
+		} else if _, _, err := SplitHostPort(attr.value); err != nil {
+			diags = append(diags, errorDiag(
+				"Invalid server."+attr.name,
+				fmt.Sprintf("The address must be in host:port form: %s.", err),
+				attrRange(cfg.Server.Body, attr.name),
+			))
+		}
+	}
+
+	listeners := serverListeners(cfg)
+	for i, l := range listeners {
+		for _, other := range listeners[:i] {
+			if other.conflicts(l) {
+				diags = append(diags, errorDiag(
+					"Invalid "+l.attr,
+					fmt.Sprintf("Port %d is already used for %s by %s.%s", l.port, other.use, other.attr, l.hint+other.hint),
+					l.rng,
+				))
+			}
+		}
+	}
+
+	if cfg.Server.UIDir != "" {
+		if info, err := os.Stat(cfg.Server.UIDir); err != nil || !info.IsDir() {
+			diags = append(diags, errorDiag(
+				"Invalid server.ui_dir",
+				fmt.Sprintf("%q is not a directory.", cfg.Server.UIDir),
+				attrRange(cfg.Server.Body, "ui_dir"),
+			))
+		}
+	}
+
+	if cfg.Mesh != nil {
+		diags = append(diags, validateMesh(cfg.Mesh)...)
+	}
+
+	if cfg.CORS != nil {
+		diags = append(diags, validateCORS(cfg.CORS)...)
+	}
+
+	if cfg.LogStore != nil {
+		diags = append(diags, validateLogStore(cfg.LogStore)...)
+	}
+
+	if cfg.Metrics != nil {
+		diags = append(diags, validateMetrics(cfg.Metrics)...)
+	}
+
+	if cfg.Prometheus != nil {
+		diags = append(diags, validatePrometheus(cfg.Prometheus)...)
+	}
+
+	if cfg.PrometheusSD != nil {
+		diags = append(diags, validatePrometheusSD(cfg.PrometheusSD)...)
+	}
+
+	if cfg.Prometheus != nil && cfg.PrometheusSD != nil &&
+		cmp.Or(cfg.Prometheus.Path, DefaultPrometheusPath) == cmp.Or(cfg.PrometheusSD.Path, DefaultPrometheusSDPath) {
+		diags = append(diags, errorDiag(
+			"Invalid prometheus_sd.path",
+			"Service discovery and metrics can't be served on the same path.",
+			attrRange(cfg.PrometheusSD.Body, "path"),
+		))
+	}
+
+	if cfg.DNS != nil {
+		diags = append(diags, validateDNS(cfg.DNS, listeners)...)
+	}
+
+	diags = append(diags, validateHealthChecks(cfg.HealthChecks)...)
+
+	if cfg.PassiveHealth != nil {
+		diags = append(diags, validatePassiveHealth(cfg.PassiveHealth, cfg.Metrics)...)
+	}
+
+	if cfg.TopologyHistory != nil {
+		diags = append(diags, validateTopologyHistory(cfg.TopologyHistory)...)
 	}
 
-	if cfg.Server.Listen == "" {
-		return fmt.Errorf("server.listen is required")
+	if diags.HasErrors() {
+		return diags
 	}
 
-	if cfg.Server.UI == "" {
-		return fmt.Errorf("server.ui is required")
+	return nil
+}
+
+// validateMesh validates the mesh block
+func validateMesh(m *MeshConfig) hcl.Diagnostics {
+	var diags hcl.Diagnostics
+
+	for _, attr := range []struct {
+		name  string
+		value string
+	}{
+		{"bind_addr", m.BindAddr},
+		{"advertise_addr", m.AdvertiseAddr},
+	} {
+		if attr.value != "" && net.ParseIP(attr.value) == nil {
+			diags = append(diags, errorDiag(
+				"Invalid mesh."+attr.name,
+				fmt.Sprintf("%q is not a valid IP address.", attr.value),
+				attrRange(m.Body, attr.name),
+			))
+		}
+	}
+
+	for _, attr := range []struct {
+		name  string
+		value int
+	}{
+		{"bind_port", m.BindPort},
+		{"advertise_port", m.AdvertisePort},
+	} {
+		if attr.value < 0 || attr.value > 65535 {
+			diags = append(diags, errorDiag(
+				"Invalid mesh."+attr.name,
+				fmt.Sprintf("Port %d is out of range; must be between 0 and 65535.", attr.value),
+				attrRange(m.Body, attr.name),
+			))
+		}
+	}
+
+	// Memberlist only advertises a port together with an address
+	if m.AdvertisePort != 0 && m.AdvertiseAddr == "" {
+		diags = append(diags, errorDiag(
+			"Missing mesh.advertise_addr",
+			"advertise_port has no effect unless advertise_addr is set.",
+			attrRange(m.Body, "advertise_port"),
+		))
+	}
+
+	for _, addr := range m.Join {
+		if addr == "" {
+			diags = append(diags, errorDiag(
+				"Invalid mesh.join",
+				"Join addresses must not be empty.",
+				attrRange(m.Body, "join"),
+			))
+		}
+	}
+
+	if m.RetryJoinInterval != "" {
+		d, err := time.ParseDuration(m.RetryJoinInterval)
+		if err != nil || d <= 0 {
+			diags = append(diags, errorDiag(
+				"Invalid mesh.retry_join_interval",
+				fmt.Sprintf("%q is not a valid positive duration (e.g. \"30s\").", m.RetryJoinInterval),
+				attrRange(m.Body, "retry_join_interval"),
+			))
+		}
+	}
+
+	if m.TopologyTTL != "" {
+		d, err := time.ParseDuration(m.TopologyTTL)
+		if err != nil || d <= 0 {
+			diags = append(diags, errorDiag(
+				"Invalid mesh.topology_ttl",
+				fmt.Sprintf("%q is not a valid positive duration (e.g. \"5m\").", m.TopologyTTL),
+				attrRange(m.Body, "topology_ttl"),
+			))
+		}
+	}
+
+	if m.RetryJoinMaxAttempts < 0 {
+		diags = append(diags, errorDiag(
+			"Invalid mesh.retry_join_max_attempts",
+			"The maximum number of join attempts must not be negative; use 0 for unlimited.",
+			attrRange(m.Body, "retry_join_max_attempts"),
+		))
+	} else if m.RetryJoinMaxAttempts > 0 && m.RetryJoinInterval == "" {
+		diags = append(diags, errorDiag(
+			"Missing mesh.retry_join_interval",
+			"retry_join_max_attempts has no effect unless retry_join_interval is set.",
+			attrRange(m.Body, "retry_join_max_attempts"),
+		))
+	}
+
+	if m.Encrypt != "" {
+		if _, err := serf.DecodeKey(m.Encrypt); err != nil {
+			diags = append(diags, errorDiag(
+				"Invalid mesh.encrypt",
+				fmt.Sprintf("The encryption key is invalid: %s. Generate one with `lattice keygen`.", err),
+				attrRange(m.Body, "encrypt"),
+			))
+		}
+	}
+
+	// A missing keyring file is created from the encryption key on start
+	if m.KeyringFile != "" {
+		if _, err := os.Stat(m.KeyringFile); !errors.Is(err, os.ErrNotExist) {
+			if _, err := serf.LoadKeyringFile(m.KeyringFile); err != nil {
+				diags = append(diags, errorDiag(
+					"Invalid mesh.keyring_file",
+					fmt.Sprintf("The keyring file can't be used: %s.", err),
+					attrRange(m.Body, "keyring_file"),
+				))
+			}
+		}
+	}
+
+	if _, err := serf.ParseRoutingMode(m.Routing); err != nil {
+		diags = append(diags, errorDiag(
+			"Invalid mesh.routing",
+			fmt.Sprintf("%s.", err),
+			attrRange(m.Body, "routing"),
+		))
+	}
+
+	return diags
+}
+
+// validateCORS validates the cors block
+func validateCORS(c *CORSConfig) hcl.Diagnostics {
+	var diags hcl.Diagnostics
+
+	if len(c.AllowedOrigins) == 0 {
+		diags = append(diags, errorDiag(
+			"Invalid cors.allowed_origins",
+			"At least one origin is required.",
+			attrRange(c.Body, "allowed_origins"),
+		))
+	}
+
+	for _, origin := range c.AllowedOrigins {
+		if origin == "*" {
+			if c.AllowCredentials {
+				diags = append(diags, errorDiag(
+					"Invalid cors.allowed_origins",
+					"The \"*\" origin cannot be combined with allow_credentials; list the origins explicitly.",
+					attrRange(c.Body, "allowed_origins"),
+				))
+			}
+			continue
+		}
+
+		u, err := url.Parse(strings.Replace(origin, "*.", "", 1))
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" || (u.Path != "" && u.Path != "/") {
+			diags = append(diags, errorDiag(
+				"Invalid cors.allowed_origins",
+				fmt.Sprintf("%q is not a valid origin; expected scheme://host[:port].", origin),
+				attrRange(c.Body, "allowed_origins"),
+			))
+		}
+	}
+
+	for _, method := range c.AllowedMethods {
+		switch strings.ToUpper(method) {
+		case http.MethodGet, http.MethodHead, http.MethodPost, http.MethodPut,
+			http.MethodPatch, http.MethodDelete:
+		default:
+			diags = append(diags, errorDiag(
+				"Invalid cors.allowed_methods",
+				fmt.Sprintf("%q is not a supported HTTP method.", method),
+				attrRange(c.Body, "allowed_methods"),
+			))
+		}
+	}
+
+	if c.MaxAge != "" {
+		if d, err := time.ParseDuration(c.MaxAge); err != nil || d < 0 {
+			diags = append(diags, errorDiag(
+				"Invalid cors.max_age",
+				fmt.Sprintf("%q is not a valid duration (e.g. \"10m\").", c.MaxAge),
+				attrRange(c.Body, "max_age"),
+			))
+		}
+	}
+
+	return diags
+}
+
+// SplitHostPort splits a host:port address and parses the port number
+func SplitHostPort(addr string) (string, int, error) {
+	host, portStr, err := net.SplitHostPort(addr)
+	if err != nil {
+		return "", 0, err
+	}
+
+	port, err := strconv.Atoi(portStr)
+	if err != nil || port < 0 || port > 65535 {
+		return "", 0, fmt.Errorf("invalid port %q", portStr)
+	}
+
+	return host, port, nil
+}
+
+// errorDiag creates an error diagnostic for the given source range
+func errorDiag(summary, detail string, subject *hcl.Range) *hcl.Diagnostic {
+	return &hcl.Diagnostic{
+		Severity: hcl.DiagError,
+		Summary:  summary,
+		Detail:   detail,
+		Subject:  subject,
+	}
+}
+
+// attrRange returns the source range of the named attribute within body.
+// If the attribute is not set, the range of the enclosing block is returned.
+func attrRange(body hcl.Body, name string) *hcl.Range {
+	if body == nil {
+		return nil
+	}
+
+	content, _, _ := body.PartialContent(&hcl.BodySchema{
+		Attributes: []hcl.AttributeSchema{{Name: name}},
+	})
+	if content != nil {
+		if attr, ok := content.Attributes[name]; ok {
+			rng := attr.Expr.Range()
+			return &rng
+		}
+	}
+
+	rng := body.MissingItemRange()
+	return &rng
+}
+
+// bodyRange returns the source range of the block containing body
+func bodyRange(body hcl.Body) *hcl.Range {
+	if body == nil {
+		return nil
+	}
+	rng := body.MissingItemRange()
+	return &rng
+}
+
+// validateLogStore validates the log_store block
+func validateLogStore(l *LogStoreConfig) hcl.Diagnostics {
+	var diags hcl.Diagnostics
+
+	if l.Path == "" {
+		diags = append(diags, errorDiag(
+			"Invalid log_store.path",
+			"A directory for the log store is required.",
+			attrRange(l.Body, "path"),
+		))
 	}
 
+	if l.MaxSizeMB < 0 {
+		diags = append(diags, errorDiag(
+			"Invalid log_store.max_size_mb",
+			"The maximum size must not be negative; omit it for the default.",
+			attrRange(l.Body, "max_size_mb"),
+		))
+	}
+
+	for _, attr := range []struct {
+		name  string
+		value string
+	}{
+		{"max_age", l.MaxAge},
+		{"collect_interval", l.CollectInterval},
+	} {
+		if attr.value == "" {
+			continue
+		}
+		if d, err := time.ParseDuration(attr.value); err != nil || d <= 0 {
+			diags = append(diags, errorDiag(
+				"Invalid log_store."+attr.name,
+				fmt.Sprintf("%q is not a valid positive duration (e.g. \"24h\").", attr.value),
+				attrRange(l.Body, attr.name),
+			))
+		}
+	}
+
+	return diags
+}
+
+// validateTopologyHistory validates the topology_history block
+func validateTopologyHistory(h *TopologyHistoryConfig) hcl.Diagnostics {
+	var diags hcl.Diagnostics
+
+	if h.Path == "" {
+		diags = append(diags, errorDiag(
+			"Invalid topology_history.path",
+			"A directory for the topology history is required.",
+			attrRange(h.Body, "path"),
+		))
+	}
+
+	if h.MaxSizeMB < 0 {
+		diags = append(diags, errorDiag(
+			"Invalid topology_history.max_size_mb",
+			"The maximum size must not be negative; omit it for the default.",
+			attrRange(h.Body, "max_size_mb"),
+		))
+	}
+
+	if h.MaxAge != "" {
+		if d, err := time.ParseDuration(h.MaxAge); err != nil || d <= 0 {
+			diags = append(diags, errorDiag(
+				"Invalid topology_history.max_age",
+				fmt.Sprintf("%q is not a valid positive duration (e.g. \"168h\").", h.MaxAge),
+				attrRange(h.Body, "max_age"),
+			))
+		}
+	}
+
+	return diags
+}
+
+// validateMetrics validates the metrics block
+func validateMetrics(m *MetricsConfig) hcl.Diagnostics {
+	var diags hcl.Diagnostics
+
+	largest := slices.Max(metrics.DefaultWindows)
+	if len(m.Windows) > 0 {
+		largest = 0
+	}
+	for _, value := range m.Windows {
+		d, err := time.ParseDuration(value)
+		if err != nil || d < metrics.DefaultResolution {
+			diags = append(diags, errorDiag(
+				"Invalid metrics.windows",
+				fmt.Sprintf("%q is not a valid duration of at least %s (e.g. \"5m\").", value, metrics.DefaultResolution),
+				attrRange(m.Body, "windows"),
+			))
+			continue
+		}
+		largest = max(largest, d)
+	}
+
+	if m.SummaryWindow != "" {
+		d, err := time.ParseDuration(m.SummaryWindow)
+		if err != nil || d < metrics.DefaultResolution {
+			diags = append(diags, errorDiag(
+				"Invalid metrics.summary_window",
+				fmt.Sprintf("%q is not a valid duration of at least %s (e.g. \"1m\").", m.SummaryWindow, metrics.DefaultResolution),
+				attrRange(m.Body, "summary_window"),
+			))
+		} else if largest > 0 && d > largest {
+			diags = append(diags, errorDiag(
+				"Invalid metrics.summary_window",
+				fmt.Sprintf("The summary window must not be longer than the largest window (%s).", largest),
+				attrRange(m.Body, "summary_window"),
+			))
+		}
+	}
+
+	if m.MaxRoutes < 0 {
+		diags = append(diags, errorDiag(
+			"Invalid metrics.max_routes",
+			"The maximum number of routes must not be negative; omit it for the default.",
+			attrRange(m.Body, "max_routes"),
+		))
+	}
+
+	if m.CollectInterval != "" {
+		if d, err := time.ParseDuration(m.CollectInterval); err != nil || d <= 0 {
+			diags = append(diags, errorDiag(
+				"Invalid metrics.collect_interval",
+				fmt.Sprintf("%q is not a valid positive duration (e.g. \"5s\").", m.CollectInterval),
+				attrRange(m.Body, "collect_interval"),
+			))
+		}
+	}
+
+	return diags
+}
+
+// validatePrometheus validates the prometheus block
+func validatePrometheus(p *PrometheusConfig) hcl.Diagnostics {
+	return validateHTTPPath("prometheus", p.Path, p.Body)
+}
+
+// validatePrometheusSD validates the prometheus_sd block
+func validatePrometheusSD(p *PrometheusSDConfig) hcl.Diagnostics {
+	diags := validateHTTPPath("prometheus_sd", p.Path, p.Body)
+
+	if p.Port < 0 || p.Port > 65535 {
+		diags = append(diags, errorDiag(
+			"Invalid prometheus_sd.port",
+			fmt.Sprintf("Port %d is out of range; must be between 1 and 65535.", p.Port),
+			attrRange(p.Body, "port"),
+		))
+	}
+
+	for from, to := range p.PortMap {
+		if port, err := strconv.Atoi(from); err != nil || port < 1 || port > 65535 || to < 1 || to > 65535 {
+			diags = append(diags, errorDiag(
+				"Invalid prometheus_sd.port_map",
+				fmt.Sprintf("%q = %d is not a valid mapping; ports must be between 1 and 65535.", from, to),
+				attrRange(p.Body, "port_map"),
+			))
+		}
+	}
+
+	return diags
+}
+
+// validateDNS validates the dns block. The DNS server can't share a port
+// with the other listeners, which all use TCP as well.
+func validateDNS(d *DNSConfig, listeners []listener) hcl.Diagnostics {
+	var diags hcl.Diagnostics
+
+	if host, port, err := SplitHostPort(cmp.Or(d.Listen, DefaultDNSListen)); err != nil {
+		diags = append(diags, errorDiag(
+			"Invalid dns.listen",
+			fmt.Sprintf("The listen address must be in host:port form: %s.", err),
+			attrRange(d.Body, "listen"),
+		))
+	} else {
+		dnsListener := listener{host: host, port: port}
+		for _, l := range listeners {
+			if l.conflicts(dnsListener) {
+				diags = append(diags, errorDiag(
+					"Invalid dns.listen",
+					fmt.Sprintf("Port %d is already used for %s by %s.%s", port, l.use, l.attr, l.hint),
+					attrRange(d.Body, "listen"),
+				))
+			}
+		}
+	}
+
+	if d.Domain != "" {
+		if _, ok := dns.IsDomainName(d.Domain); !ok || strings.Trim(d.Domain, ".") == "" {
+			diags = append(diags, errorDiag(
+				"Invalid dns.domain",
+				fmt.Sprintf("%q is not a valid domain name (e.g. \"lattice.\").", d.Domain),
+				attrRange(d.Body, "domain"),
+			))
+		}
+	}
+
+	if d.TTL != "" {
+		if ttl, err := time.ParseDuration(d.TTL); err != nil || ttl < 0 {
+			diags = append(diags, errorDiag(
+				"Invalid dns.ttl",
+				fmt.Sprintf("%q is not a valid duration (e.g. \"5s\"); use \"0s\" to disable caching.", d.TTL),
+				attrRange(d.Body, "ttl"),
+			))
+		}
+	}
+
+	return diags
+}
+
+// listener is an address Lattice listens on
+type listener struct {
+	attr string     // Attribute that sets the port, e.g. "server.listen"
+	use  string     // What the listener serves
+	rng  *hcl.Range // Source range of the attribute
+	host string
+	port int
+
+	// hint is appended to conflict errors, for listeners that are on by
+	// default and so may not appear in the file
+	hint string
+}
+
+// conflicts reports whether two listeners would bind the same port. Port 0
+// picks a free port, so it never conflicts.
+func (l listener) conflicts(other listener) bool {
+	return l.port != 0 && l.port == other.port && hostsOverlap(l.host, other.host)
+}
+
+// serverListeners returns the gossip, UI and admin listeners, leaving out
+// any whose address is invalid. The gossip listener binds to server.listen
+// unless the mesh block overrides its host or port.
+func serverListeners(cfg *Config) []listener {
+	var listeners []listener
+
+	if host, port, err := SplitHostPort(cfg.Server.Listen); err == nil {
+		gossip := listener{
+			attr: "server.listen",
+			use:  "gossip",
+			rng:  attrRange(cfg.Server.Body, "listen"),
+			host: host,
+			port: port,
+		}
+		if m := cfg.Mesh; m != nil {
+			if m.BindAddr != "" {
+				gossip.host = m.BindAddr
+			}
+			if m.BindPort != 0 {
+				gossip.attr = "mesh.bind_port"
+				gossip.rng = attrRange(m.Body, "bind_port")
+				gossip.port = m.BindPort
+			}
+		}
+		listeners = append(listeners, gossip)
+	}
+
+	if host, port, err := SplitHostPort(cfg.Server.UI); err == nil {
+		listeners = append(listeners, listener{
+			attr: "server.ui",
+			use:  "the web UI",
+			rng:  attrRange(cfg.Server.Body, "ui"),
+			host: host,
+			port: port,
+		})
+	}
+
+	if host, port, err := SplitHostPort(cmp.Or(cfg.Server.Admin, DefaultAdminListen)); err == nil {
+		admin := listener{
+			attr: "server.admin",
+			use:  "the admin API",
+			rng:  attrRange(cfg.Server.Body, "admin"),
+			host: host,
+			port: port,
+		}
+		if cfg.Server.Admin == "" {
+			admin.hint = fmt.Sprintf(" The admin API listens on %s unless server.admin is set.", DefaultAdminListen)
+		}
+		listeners = append(listeners, admin)
+	}
+
+	return listeners
+}
+
+// hostsOverlap reports whether listeners on the two hosts could share an
+// address, where an empty or unspecified host listens on all of them
+func hostsOverlap(a, b string) bool {
+	unspecified := func(host string) bool {
+		ip := net.ParseIP(host)
+		return host == "" || (ip != nil && ip.IsUnspecified())
+	}
+	return a == b || unspecified(a) || unspecified(b)
+}
+
+// validateHealthChecks validates the health_check blocks
+func validateHealthChecks(checks []*HealthCheckConfig) hcl.Diagnostics {
+	var diags hcl.Diagnostics
+
+	seen := make(map[string]bool)
+	for _, c := range checks {
+		if seen[c.Service] {
+			diags = append(diags, errorDiag(
+				"Duplicate health_check block",
+				fmt.Sprintf("A health check for %q is already defined.", c.Service),
+				attrRange(c.Body, "type"),
+			))
+		}
+		seen[c.Service] = true
+
+		switch c.Type {
+		case health.TypeHTTP:
+			if c.Path != "" && !strings.HasPrefix(c.Path, "/") {
+				diags = append(diags, errorDiag(
+					"Invalid health_check.path",
+					fmt.Sprintf("%q must be an absolute path (e.g. \"/healthz\").", c.Path),
+					attrRange(c.Body, "path"),
+				))
+			}
+		case health.TypeTCP:
+			if c.Path != "" {
+				diags = append(diags, errorDiag(
+					"Invalid health_check.path",
+					"A path can only be set on http checks.",
+					attrRange(c.Body, "path"),
+				))
+			}
+		default:
+			diags = append(diags, errorDiag(
+				"Invalid health_check.type",
+				fmt.Sprintf("%q is not a valid check type; must be \"http\" or \"tcp\".", c.Type),
+				attrRange(c.Body, "type"),
+			))
+		}
+
+		durations := make(map[string]time.Duration)
+		for _, attr := range []struct {
+			name  string
+			value string
+		}{
+			{"interval", c.Interval},
+			{"timeout", c.Timeout},
+		} {
+			if attr.value == "" {
+				continue
+			}
+			d, err := time.ParseDuration(attr.value)
+			if err != nil || d <= 0 {
+				diags = append(diags, errorDiag(
+					"Invalid health_check."+attr.name,
+					fmt.Sprintf("%q is not a valid positive duration (e.g. \"10s\").", attr.value),
+					attrRange(c.Body, attr.name),
+				))
+				continue
+			}
+			durations[attr.name] = d
+		}
+
+		interval := cmp.Or(durations["interval"], health.DefaultInterval)
+		if timeout := cmp.Or(durations["timeout"], health.DefaultTimeout); timeout > interval {
+			diags = append(diags, errorDiag(
+				"Invalid health_check.timeout",
+				fmt.Sprintf("The timeout (%s) must not be longer than the interval (%s).", timeout, interval),
+				attrRange(c.Body, "timeout"),
+			))
+		}
+
+		for _, attr := range []struct {
+			name  string
+			value int
+		}{
+			{"healthy_threshold", c.HealthyThreshold},
+			{"unhealthy_threshold", c.UnhealthyThreshold},
+		} {
+			if attr.value < 0 {
+				diags = append(diags, errorDiag(
+					"Invalid health_check."+attr.name,
+					"The threshold must not be negative; omit it for the default.",
+					attrRange(c.Body, attr.name),
+				))
+			}
+		}
+	}
+
+	return diags
+}
+
+// validatePassiveHealth validates the passive_health block. Error rates are
+// computed by the metrics block, which also bounds the window.
+func validatePassiveHealth(p *PassiveHealthConfig, m *MetricsConfig) hcl.Diagnostics {
+	var diags hcl.Diagnostics
+
+	if m == nil {
+		diags = append(diags, errorDiag(
+			"Missing metrics block",
+			"Passive health checks use request metrics; add a metrics block.",
+			bodyRange(p.Body),
+		))
+	}
+
+	if p.Window != "" {
+		d, err := time.ParseDuration(p.Window)
+		if err != nil || d < metrics.DefaultResolution {
+			diags = append(diags, errorDiag(
+				"Invalid passive_health.window",
+				fmt.Sprintf("%q is not a valid duration of at least %s (e.g. \"1m\").", p.Window, metrics.DefaultResolution),
+				attrRange(p.Body, "window"),
+			))
+		} else if largest := largestMetricsWindow(m); m != nil && d > largest {
+			diags = append(diags, errorDiag(
+				"Invalid passive_health.window",
+				fmt.Sprintf("The window must not be longer than the largest metrics window (%s).", largest),
+				attrRange(p.Body, "window"),
+			))
+		}
+	}
+
+	if p.MinRequests < 0 {
+		diags = append(diags, errorDiag(
+			"Invalid passive_health.min_requests",
+			"The minimum number of requests must not be negative; omit it for the default.",
+			attrRange(p.Body, "min_requests"),
+		))
+	}
+
+	for _, attr := range []struct {
+		name  string
+		value float64
+	}{
+		{"degraded_error_rate", p.DegradedErrorRate},
+		{"unhealthy_error_rate", p.UnhealthyErrorRate},
+	} {
+		if attr.value < 0 || attr.value > 1 {
+			diags = append(diags, errorDiag(
+				"Invalid passive_health."+attr.name,
+				fmt.Sprintf("%g is not a fraction between 0 and 1 (e.g. 0.05 for 5%%).", attr.value),
+				attrRange(p.Body, attr.name),
+			))
+		}
+	}
+
+	degraded := cmp.Or(p.DegradedErrorRate, health.DefaultDegradedErrorRate)
+	unhealthy := cmp.Or(p.UnhealthyErrorRate, health.DefaultUnhealthyErrorRate)
+	if degraded >= unhealthy {
+		diags = append(diags, errorDiag(
+			"Invalid passive_health.degraded_error_rate",
+			fmt.Sprintf("The degraded error rate (%g) must be lower than the unhealthy error rate (%g).", degraded, unhealthy),
+			attrRange(p.Body, "degraded_error_rate"),
+		))
+	}
+
+	return diags
+}
+
+// largestMetricsWindow returns the longest window the metrics block keeps,
+// ignoring invalid windows
+func largestMetricsWindow(m *MetricsConfig) time.Duration {
+	if m == nil || len(m.Windows) == 0 {
+		return slices.Max(metrics.DefaultWindows)
+	}
+
+	var largest time.Duration
+	for _, value := range m.Windows {
+		if d, err := time.ParseDuration(value); err == nil {
+			largest = max(largest, d)
+		}
+	}
+	return largest
+}
+
+// validateHTTPPath validates the path attribute of a block that serves an
+// endpoint on the UI listener
+func validateHTTPPath(block, path string, body hcl.Body) hcl.Diagnostics {
+	// The root path serves the web UI
+	if path != "" && (!strings.HasPrefix(path, "/") || path == "/") {
+		return hcl.Diagnostics{errorDiag(
+			"Invalid "+block+".path",
+			fmt.Sprintf("%q must be an absolute path other than \"/\" (e.g. \"/metrics\").", path),
+			attrRange(body, "path"),
+		)}
+	}
 	return nil
 }
@@ -0,0 +1,816 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/stretchr/testify/require"
+)
+
+func writeConfig(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "lattice.hcl")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	return path
+}
+
+func TestParseFileMeshBlock(t *testing.T) {
+	path := writeConfig(t, `
+server {
+  listen = "0.0.0.0:7946"
+  ui     = "0.0.0.0:9000"
+}
+
+mesh {
+  node_name               = "lattice-a"
+  bind_addr               = "10.0.0.5"
+  bind_port               = 8301
+  advertise_addr          = "192.168.1.5"
+  advertise_port          = 18301
+  join                    = ["10.0.0.6:8301", "10.0.0.7:8301"]
+  retry_join_interval     = "15s"
+  retry_join_max_attempts = 4
+  tags = {
+    role = "observer"
+  }
+}
+`)
+
+	cfg, err := ParseFile(path)
+	require.NoError(t, err)
+	require.NoError(t, Validate(cfg))
+
+	require.NotNil(t, cfg.Mesh)
+	require.Equal(t, "lattice-a", cfg.Mesh.NodeName)
+	require.Equal(t, "10.0.0.5", cfg.Mesh.BindAddr)
+	require.Equal(t, 8301, cfg.Mesh.BindPort)
+	require.Equal(t, "192.168.1.5", cfg.Mesh.AdvertiseAddr)
+	require.Equal(t, 18301, cfg.Mesh.AdvertisePort)
+	require.Equal(t, []string{"10.0.0.6:8301", "10.0.0.7:8301"}, cfg.Mesh.Join)
+	require.Equal(t, "15s", cfg.Mesh.RetryJoinInterval)
+	require.Equal(t, 4, cfg.Mesh.RetryJoinMaxAttempts)
+	require.Equal(t, "observer", cfg.Mesh.Tags["role"])
+}
+
+func TestParseFileWithoutMeshBlock(t *testing.T) {
+	path := writeConfig(t, `
+server {
+  listen = "0.0.0.0:7946"
+  ui     = "0.0.0.0:9000"
+}
+`)
+
+	cfg, err := ParseFile(path)
+	require.NoError(t, err)
+	require.NoError(t, Validate(cfg))
+	require.Nil(t, cfg.Mesh)
+}
+
+func TestValidateReportsRange(t *testing.T) {
+	path := writeConfig(t, `
+server {
+  listen = "0.0.0.0:7946"
+  ui     = "0.0.0.0:9000"
+}
+
+mesh {
+  bind_addr           = "not-an-ip"
+  retry_join_interval = "soon"
+}
+`)
+
+	cfg, err := ParseFile(path)
+	require.NoError(t, err)
+
+	err = Validate(cfg)
+	require.Error(t, err)
+
+	var diags hcl.Diagnostics
+	require.ErrorAs(t, err, &diags)
+	require.Len(t, diags, 2)
+
+	require.Equal(t, "Invalid mesh.bind_addr", diags[0].Summary)
+	require.NotNil(t, diags[0].Subject)
+	require.Equal(t, path, diags[0].Subject.Filename)
+	require.Equal(t, 8, diags[0].Subject.Start.Line)
+
+	require.Equal(t, "Invalid mesh.retry_join_interval", diags[1].Summary)
+	require.Equal(t, 9, diags[1].Subject.Start.Line)
+}
+
+func TestValidateListenAddress(t *testing.T) {
+	cfg := &Config{
+		Server: &ServerConfig{
+			Listen: "7946",
+			UI:     "0.0.0.0:9000",
+		},
+	}
+
+	err := Validate(cfg)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Invalid server.listen")
+}
+
+func TestValidateServer(t *testing.T) {
+	path := writeConfig(t, `
+mesh {
+  node_name = "lattice-a"
+}
+`)
+
+	cfg, err := ParseFile(path)
+	require.NoError(t, err)
+
+	err = Validate(cfg)
+	var diags hcl.Diagnostics
+	require.ErrorAs(t, err, &diags)
+	require.Len(t, diags, 1)
+	require.Equal(t, "Missing server block", diags[0].Summary)
+	require.Equal(t, path, diags[0].Subject.Filename)
+
+	tests := []struct {
+		name   string
+		listen string
+		ui     string
+		mesh   *MeshConfig
+		detail string
+	}{
+		{"empty listen", "", "0.0.0.0:9000", nil, "must not be empty"},
+		{"empty ui", "0.0.0.0:7946", "", nil, "must not be empty"},
+		{"ui missing port", "0.0.0.0:7946", "localhost", nil, "host:port"},
+		{"ui on gossip port", "0.0.0.0:7946", "127.0.0.1:7946", nil, "used for gossip by server.listen"},
+		{"ui on bind port", "0.0.0.0:7946", "127.0.0.1:9000", &MeshConfig{BindPort: 9000}, "used for gossip by mesh.bind_port"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{
+				Server: &ServerConfig{Listen: tt.listen, UI: tt.ui},
+				Mesh:   tt.mesh,
+			}
+			err := Validate(cfg)
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tt.detail)
+		})
+	}
+
+	// Different hosts can share a port
+	require.NoError(t, Validate(&Config{
+		Server: &ServerConfig{Listen: "10.0.0.5:7946", UI: "127.0.0.1:7946"},
+	}))
+}
+
+func TestValidateAdmin(t *testing.T) {
+	cfg := &Config{
+		Server: &ServerConfig{
+			Listen: "0.0.0.0:7946",
+			UI:     "0.0.0.0:9000",
+			Admin:  "127.0.0.1:9100",
+		},
+	}
+	require.NoError(t, Validate(cfg))
+
+	cfg.Server.Admin = "localhost"
+	err := Validate(cfg)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Invalid server.admin")
+
+	cfg.Server.Admin = "127.0.0.1:9000"
+	err = Validate(cfg)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "used for the web UI by server.ui")
+
+	// The default admin address conflicts with a UI on the same port
+	cfg.Server.Admin = ""
+	cfg.Server.UI = DefaultAdminListen
+	err = Validate(cfg)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Invalid server.admin")
+	require.Contains(t, err.Error(), "unless server.admin is set")
+
+	// So does a DNS server on the default admin port
+	cfg.Server.UI = "0.0.0.0:9000"
+	cfg.DNS = &DNSConfig{Listen: DefaultAdminListen}
+	err = Validate(cfg)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "used for the admin API by server.admin. The admin API listens on 127.0.0.1:9001 unless server.admin is set.")
+}
+
+func TestParseFileDuplicateBlock(t *testing.T) {
+	path := writeConfig(t, `
+server {
+  listen = "0.0.0.0:7946"
+  ui     = "0.0.0.0:9000"
+}
+
+server {
+  listen = "0.0.0.0:7947"
+  ui     = "0.0.0.0:9001"
+}
+`)
+
+	_, err := ParseFile(path)
+	var diags hcl.Diagnostics
+	require.ErrorAs(t, err, &diags)
+	require.Equal(t, "Duplicate server block", diags[0].Summary)
+	require.Equal(t, 7, diags[0].Subject.Start.Line)
+}
+
+func TestValidateRetryJoinMaxAttemptsRequiresInterval(t *testing.T) {
+	cfg := &Config{
+		Server: &ServerConfig{
+			Listen: "0.0.0.0:7946",
+			UI:     "0.0.0.0:9000",
+		},
+		Mesh: &MeshConfig{
+			RetryJoinMaxAttempts: 3,
+		},
+	}
+
+	err := Validate(cfg)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "retry_join_interval")
+}
+
+func TestValidateAdvertisePortRequiresAddr(t *testing.T) {
+	cfg := &Config{
+		Server: &ServerConfig{
+			Listen: "0.0.0.0:7946",
+			UI:     "0.0.0.0:9000",
+		},
+		Mesh: &MeshConfig{
+			AdvertisePort: 17946,
+		},
+	}
+
+	err := Validate(cfg)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Missing mesh.advertise_addr")
+
+	cfg.Mesh.AdvertiseAddr = "192.168.1.5"
+	require.NoError(t, Validate(cfg))
+}
+
+func TestValidateUIDir(t *testing.T) {
+	cfg := &Config{
+		Server: &ServerConfig{
+			Listen: "0.0.0.0:7946",
+			UI:     "0.0.0.0:9000",
+			UIDir:  t.TempDir(),
+		},
+	}
+	require.NoError(t, Validate(cfg))
+
+	cfg.Server.UIDir = filepath.Join(cfg.Server.UIDir, "missing")
+	err := Validate(cfg)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Invalid server.ui_dir")
+}
+
+func TestParseFileCORSBlock(t *testing.T) {
+	path := writeConfig(t, `
+server {
+  listen = "0.0.0.0:7946"
+  ui     = "0.0.0.0:9000"
+}
+
+cors {
+  allowed_origins   = ["http://localhost:3000", "https://*.example.com"]
+  allowed_methods   = ["GET", "POST"]
+  allowed_headers   = ["Authorization"]
+  allow_credentials = true
+  max_age           = "10m"
+}
+`)
+
+	cfg, err := ParseFile(path)
+	require.NoError(t, err)
+	require.NoError(t, Validate(cfg))
+
+	require.NotNil(t, cfg.CORS)
+	require.Equal(t, []string{"http://localhost:3000", "https://*.example.com"}, cfg.CORS.AllowedOrigins)
+	require.Equal(t, []string{"Authorization"}, cfg.CORS.AllowedHeaders)
+	require.True(t, cfg.CORS.AllowCredentials)
+	require.Equal(t, "10m", cfg.CORS.MaxAge)
+}
+
+func TestValidateCORS(t *testing.T) {
+	tests := []struct {
+		name    string
+		cors    *CORSConfig
+		summary string
+	}{
+		{
+			name:    "no origins",
+			cors:    &CORSConfig{},
+			summary: "Invalid cors.allowed_origins",
+		},
+		{
+			name:    "wildcard with credentials",
+			cors:    &CORSConfig{AllowedOrigins: []string{"*"}, AllowCredentials: true},
+			summary: "Invalid cors.allowed_origins",
+		},
+		{
+			name:    "origin with path",
+			cors:    &CORSConfig{AllowedOrigins: []string{"http://localhost:3000/app"}},
+			summary: "Invalid cors.allowed_origins",
+		},
+		{
+			name:    "unknown method",
+			cors:    &CORSConfig{AllowedOrigins: []string{"*"}, AllowedMethods: []string{"FETCH"}},
+			summary: "Invalid cors.allowed_methods",
+		},
+		{
+			name:    "bad max age",
+			cors:    &CORSConfig{AllowedOrigins: []string{"*"}, MaxAge: "ten minutes"},
+			summary: "Invalid cors.max_age",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{
+				Server: &ServerConfig{
+					Listen: "0.0.0.0:7946",
+					UI:     "0.0.0.0:9000",
+				},
+				CORS: tt.cors,
+			}
+
+			err := Validate(cfg)
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tt.summary)
+		})
+	}
+}
+
+func TestValidateMeshEncrypt(t *testing.T) {
+	cfg := &Config{
+		Server: &ServerConfig{
+			Listen: "0.0.0.0:7946",
+			UI:     "0.0.0.0:9000",
+		},
+		Mesh: &MeshConfig{
+			Encrypt: "pUqJrVyVRj5jsiYEkM/tFQYfWyJIv4s3XkvDwy7Cu5s=",
+		},
+	}
+	require.NoError(t, Validate(cfg))
+
+	cfg.Mesh.Encrypt = "dG9vLXNob3J0"
+	err := Validate(cfg)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Invalid mesh.encrypt")
+}
+
+func TestValidateMeshKeyringFile(t *testing.T) {
+	keyring := filepath.Join(t.TempDir(), "keyring.json")
+	cfg := &Config{
+		Server: &ServerConfig{
+			Listen: "0.0.0.0:7946",
+			UI:     "0.0.0.0:9000",
+		},
+		Mesh: &MeshConfig{
+			KeyringFile: keyring,
+		},
+	}
+
+	// Created on start if it doesn't exist
+	require.NoError(t, Validate(cfg))
+
+	require.NoError(t, os.WriteFile(keyring, []byte(`["pUqJrVyVRj5jsiYEkM/tFQYfWyJIv4s3XkvDwy7Cu5s="]`), 0o600))
+	require.NoError(t, Validate(cfg))
+
+	require.NoError(t, os.WriteFile(keyring, []byte(`["dG9vLXNob3J0"]`), 0o600))
+	err := Validate(cfg)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Invalid mesh.keyring_file")
+}
+
+func TestValidateMeshTopologyTTL(t *testing.T) {
+	cfg := &Config{
+		Server: &ServerConfig{
+			Listen: "0.0.0.0:7946",
+			UI:     "0.0.0.0:9000",
+		},
+		Mesh: &MeshConfig{
+			TopologyTTL: "5m",
+		},
+	}
+	require.NoError(t, Validate(cfg))
+
+	cfg.Mesh.TopologyTTL = "-1m"
+	err := Validate(cfg)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Invalid mesh.topology_ttl")
+}
+
+func TestValidateMeshRouting(t *testing.T) {
+	cfg := &Config{
+		Server: &ServerConfig{
+			Listen: "0.0.0.0:7946",
+			UI:     "0.0.0.0:9000",
+		},
+		Mesh: &MeshConfig{
+			Routing: "latency",
+		},
+	}
+	require.NoError(t, Validate(cfg))
+
+	cfg.Mesh.Routing = "fastest"
+	err := Validate(cfg)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Invalid mesh.routing")
+}
+
+func TestParseLogStore(t *testing.T) {
+	path := writeConfig(t, `
+server {
+  listen = "0.0.0.0:7946"
+  ui     = "0.0.0.0:9000"
+}
+
+log_store {
+  path             = "/var/lib/lattice/logs"
+  max_size_mb      = 512
+  max_age          = "72h"
+  collect_interval = "10s"
+}
+`)
+
+	cfg, err := ParseFile(path)
+	require.NoError(t, err)
+	require.NotNil(t, cfg.LogStore)
+	require.Equal(t, "/var/lib/lattice/logs", cfg.LogStore.Path)
+	require.Equal(t, 512, cfg.LogStore.MaxSizeMB)
+	require.Equal(t, "72h", cfg.LogStore.MaxAge)
+	require.Equal(t, "10s", cfg.LogStore.CollectInterval)
+	require.NoError(t, Validate(cfg))
+}
+
+func TestValidateLogStore(t *testing.T) {
+	tests := []struct {
+		name     string
+		logStore *LogStoreConfig
+		summary  string
+	}{
+		{"missing path", &LogStoreConfig{}, "Invalid log_store.path"},
+		{"negative size", &LogStoreConfig{Path: "logs", MaxSizeMB: -1}, "Invalid log_store.max_size_mb"},
+		{"bad max age", &LogStoreConfig{Path: "logs", MaxAge: "a week"}, "Invalid log_store.max_age"},
+		{"zero interval", &LogStoreConfig{Path: "logs", CollectInterval: "0s"}, "Invalid log_store.collect_interval"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{
+				Server: &ServerConfig{
+					Listen: "0.0.0.0:7946",
+					UI:     "0.0.0.0:9000",
+				},
+				LogStore: tt.logStore,
+			}
+			err := Validate(cfg)
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tt.summary)
+		})
+	}
+}
+
+func TestParseMetrics(t *testing.T) {
+	path := writeConfig(t, `
+server {
+  listen = "0.0.0.0:7946"
+  ui     = "0.0.0.0:9000"
+}
+
+metrics {
+  windows          = ["30s", "5m"]
+  summary_window   = "30s"
+  max_routes       = 50
+  collect_interval = "2s"
+}
+`)
+
+	cfg, err := ParseFile(path)
+	require.NoError(t, err)
+	require.NotNil(t, cfg.Metrics)
+	require.Equal(t, []string{"30s", "5m"}, cfg.Metrics.Windows)
+	require.Equal(t, "30s", cfg.Metrics.SummaryWindow)
+	require.Equal(t, 50, cfg.Metrics.MaxRoutes)
+	require.Equal(t, "2s", cfg.Metrics.CollectInterval)
+	require.NoError(t, Validate(cfg))
+}
+
+func TestValidateMetrics(t *testing.T) {
+	tests := []struct {
+		name    string
+		metrics *MetricsConfig
+		summary string
+	}{
+		{"bad window", &MetricsConfig{Windows: []string{"1m", "soon"}}, "Invalid metrics.windows"},
+		{"window too short", &MetricsConfig{Windows: []string{"1s"}}, "Invalid metrics.windows"},
+		{"summary too long", &MetricsConfig{Windows: []string{"1m"}, SummaryWindow: "5m"}, "Invalid metrics.summary_window"},
+		{"summary beyond defaults", &MetricsConfig{SummaryWindow: "1h"}, "Invalid metrics.summary_window"},
+		{"negative routes", &MetricsConfig{MaxRoutes: -1}, "Invalid metrics.max_routes"},
+		{"bad interval", &MetricsConfig{CollectInterval: "-5s"}, "Invalid metrics.collect_interval"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{
+				Server: &ServerConfig{
+					Listen: "0.0.0.0:7946",
+					UI:     "0.0.0.0:9000",
+				},
+				Metrics: tt.metrics,
+			}
+			err := Validate(cfg)
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tt.summary)
+		})
+	}
+}
+
+func TestParsePrometheus(t *testing.T) {
+	path := writeConfig(t, `
+server {
+  listen = "0.0.0.0:7946"
+  ui     = "0.0.0.0:9000"
+}
+
+prometheus {
+  path = "/internal/metrics"
+}
+`)
+
+	cfg, err := ParseFile(path)
+	require.NoError(t, err)
+	require.NotNil(t, cfg.Prometheus)
+	require.Equal(t, "/internal/metrics", cfg.Prometheus.Path)
+	require.NoError(t, Validate(cfg))
+}
+
+func TestValidatePrometheus(t *testing.T) {
+	for _, path := range []string{"metrics", "/"} {
+		t.Run(path, func(t *testing.T) {
+			cfg := &Config{
+				Server: &ServerConfig{
+					Listen: "0.0.0.0:7946",
+					UI:     "0.0.0.0:9000",
+				},
+				Prometheus: &PrometheusConfig{Path: path},
+			}
+			err := Validate(cfg)
+			require.Error(t, err)
+			require.Contains(t, err.Error(), "Invalid prometheus.path")
+		})
+	}
+}
+
+func TestParsePrometheusSD(t *testing.T) {
+	path := writeConfig(t, `
+server {
+  listen = "0.0.0.0:7946"
+  ui     = "0.0.0.0:9000"
+}
+
+prometheus_sd {
+  path     = "/sd"
+  types    = ["http", "grpc"]
+  tags     = { env = "prod" }
+  port     = 9090
+  port_map = { "5432" = 9187 }
+}
+`)
+
+	cfg, err := ParseFile(path)
+	require.NoError(t, err)
+	require.NotNil(t, cfg.PrometheusSD)
+	require.Equal(t, "/sd", cfg.PrometheusSD.Path)
+	require.Equal(t, []string{"http", "grpc"}, cfg.PrometheusSD.Types)
+	require.Equal(t, map[string]string{"env": "prod"}, cfg.PrometheusSD.Tags)
+	require.Equal(t, 9090, cfg.PrometheusSD.Port)
+	require.Equal(t, map[string]int{"5432": 9187}, cfg.PrometheusSD.PortMap)
+	require.NoError(t, Validate(cfg))
+}
+
+func TestValidatePrometheusSD(t *testing.T) {
+	tests := []struct {
+		name    string
+		sd      *PrometheusSDConfig
+		summary string
+	}{
+		{"relative path", &PrometheusSDConfig{Path: "sd"}, "Invalid prometheus_sd.path"},
+		{"port out of range", &PrometheusSDConfig{Port: 70000}, "Invalid prometheus_sd.port"},
+		{"non-numeric mapping", &PrometheusSDConfig{PortMap: map[string]int{"http": 9090}}, "Invalid prometheus_sd.port_map"},
+		{"mapping to zero", &PrometheusSDConfig{PortMap: map[string]int{"8080": 0}}, "Invalid prometheus_sd.port_map"},
+		{"metrics path", &PrometheusSDConfig{Path: "/metrics"}, "Invalid prometheus_sd.path"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{
+				Server: &ServerConfig{
+					Listen: "0.0.0.0:7946",
+					UI:     "0.0.0.0:9000",
+				},
+				Prometheus:   &PrometheusConfig{},
+				PrometheusSD: tt.sd,
+			}
+			err := Validate(cfg)
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tt.summary)
+		})
+	}
+}
+
+func TestParseDNS(t *testing.T) {
+	path := writeConfig(t, `
+server {
+  listen = "0.0.0.0:7946"
+  ui     = "0.0.0.0:9000"
+}
+
+dns {
+  listen = "127.0.0.1:53"
+  domain = "mesh.internal."
+  ttl    = "10s"
+}
+`)
+
+	cfg, err := ParseFile(path)
+	require.NoError(t, err)
+	require.NotNil(t, cfg.DNS)
+	require.Equal(t, "127.0.0.1:53", cfg.DNS.Listen)
+	require.Equal(t, "mesh.internal.", cfg.DNS.Domain)
+	require.Equal(t, "10s", cfg.DNS.TTL)
+	require.NoError(t, Validate(cfg))
+}
+
+func TestValidateDNS(t *testing.T) {
+	tests := []struct {
+		name    string
+		dns     *DNSConfig
+		summary string
+	}{
+		{"missing port", &DNSConfig{Listen: "127.0.0.1"}, "Invalid dns.listen"},
+		{"gossip port", &DNSConfig{Listen: "127.0.0.1:7946"}, "Invalid dns.listen"},
+		{"ui port", &DNSConfig{Listen: "127.0.0.1:9000"}, "used for the web UI by server.ui"},
+		{"invalid domain", &DNSConfig{Domain: "bad..domain"}, "Invalid dns.domain"},
+		{"root domain", &DNSConfig{Domain: "."}, "Invalid dns.domain"},
+		{"negative ttl", &DNSConfig{TTL: "-5s"}, "Invalid dns.ttl"},
+		{"invalid ttl", &DNSConfig{TTL: "soon"}, "Invalid dns.ttl"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{
+				Server: &ServerConfig{
+					Listen: "0.0.0.0:7946",
+					UI:     "0.0.0.0:9000",
+				},
+				DNS: tt.dns,
+			}
+			err := Validate(cfg)
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tt.summary)
+		})
+	}
+}
+
+func TestParseHealth(t *testing.T) {
+	path := writeConfig(t, `
+server {
+  listen = "0.0.0.0:7946"
+  ui     = "0.0.0.0:9000"
+}
+
+metrics {}
+
+health_check "users" {
+  type                = "http"
+  path                = "/healthz"
+  interval            = "5s"
+  timeout             = "1s"
+  healthy_threshold   = 1
+  unhealthy_threshold = 2
+}
+
+health_check "*" {
+  type = "tcp"
+}
+
+passive_health {
+  window               = "5m"
+  min_requests         = 50
+  degraded_error_rate  = 0.01
+  unhealthy_error_rate = 0.2
+}
+`)
+
+	cfg, err := ParseFile(path)
+	require.NoError(t, err)
+	require.Len(t, cfg.HealthChecks, 2)
+	require.Equal(t, "users", cfg.HealthChecks[0].Service)
+	require.Equal(t, "http", cfg.HealthChecks[0].Type)
+	require.Equal(t, "/healthz", cfg.HealthChecks[0].Path)
+	require.Equal(t, "5s", cfg.HealthChecks[0].Interval)
+	require.Equal(t, 2, cfg.HealthChecks[0].UnhealthyThreshold)
+	require.Equal(t, "*", cfg.HealthChecks[1].Service)
+	require.NotNil(t, cfg.PassiveHealth)
+	require.Equal(t, "5m", cfg.PassiveHealth.Window)
+	require.Equal(t, 50, cfg.PassiveHealth.MinRequests)
+	require.Equal(t, 0.2, cfg.PassiveHealth.UnhealthyErrorRate)
+	require.NoError(t, Validate(cfg))
+}
+
+func TestValidateHealth(t *testing.T) {
+	tests := []struct {
+		name    string
+		checks  []*HealthCheckConfig
+		passive *PassiveHealthConfig
+		metrics *MetricsConfig
+		summary string
+	}{
+		{"unknown type", []*HealthCheckConfig{{Service: "users", Type: "grpc"}}, nil, nil, "Invalid health_check.type"},
+		{"relative path", []*HealthCheckConfig{{Service: "users", Type: "http", Path: "healthz"}}, nil, nil, "Invalid health_check.path"},
+		{"tcp path", []*HealthCheckConfig{{Service: "users", Type: "tcp", Path: "/healthz"}}, nil, nil, "Invalid health_check.path"},
+		{"invalid interval", []*HealthCheckConfig{{Service: "users", Type: "tcp", Interval: "often"}}, nil, nil, "Invalid health_check.interval"},
+		{"timeout exceeds interval", []*HealthCheckConfig{{Service: "users", Type: "tcp", Interval: "1s", Timeout: "5s"}}, nil, nil, "Invalid health_check.timeout"},
+		{"negative threshold", []*HealthCheckConfig{{Service: "users", Type: "tcp", UnhealthyThreshold: -1}}, nil, nil, "Invalid health_check.unhealthy_threshold"},
+		{"duplicate", []*HealthCheckConfig{{Service: "users", Type: "tcp"}, {Service: "users", Type: "http"}}, nil, nil, "Duplicate health_check block"},
+		{"without metrics", nil, &PassiveHealthConfig{}, nil, "Missing metrics block"},
+		{"window too short", nil, &PassiveHealthConfig{Window: "1s"}, &MetricsConfig{}, "Invalid passive_health.window"},
+		{"window too long", nil, &PassiveHealthConfig{Window: "10m"}, &MetricsConfig{Windows: []string{"1m", "5m"}}, "Invalid passive_health.window"},
+		{"rate above one", nil, &PassiveHealthConfig{UnhealthyErrorRate: 5}, &MetricsConfig{}, "Invalid passive_health.unhealthy_error_rate"},
+		{"rates inverted", nil, &PassiveHealthConfig{DegradedErrorRate: 0.5, UnhealthyErrorRate: 0.1}, &MetricsConfig{}, "Invalid passive_health.degraded_error_rate"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{
+				Server: &ServerConfig{
+					Listen: "0.0.0.0:7946",
+					UI:     "0.0.0.0:9000",
+				},
+				Metrics:       tt.metrics,
+				HealthChecks:  tt.checks,
+				PassiveHealth: tt.passive,
+			}
+			err := Validate(cfg)
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tt.summary)
+		})
+	}
+}
+
+func TestParseTopologyHistory(t *testing.T) {
+	path := writeConfig(t, `
+server {
+  listen = "0.0.0.0:7946"
+  ui     = "0.0.0.0:9000"
+}
+
+topology_history {
+  path        = "/var/lib/lattice/history"
+  max_size_mb = 64
+  max_age     = "720h"
+}
+`)
+
+	cfg, err := ParseFile(path)
+	require.NoError(t, err)
+	require.NotNil(t, cfg.TopologyHistory)
+	require.Equal(t, "/var/lib/lattice/history", cfg.TopologyHistory.Path)
+	require.Equal(t, 64, cfg.TopologyHistory.MaxSizeMB)
+	require.Equal(t, "720h", cfg.TopologyHistory.MaxAge)
+	require.NoError(t, Validate(cfg))
+}
+
+func TestValidateTopologyHistory(t *testing.T) {
+	tests := []struct {
+		name    string
+		history *TopologyHistoryConfig
+		summary string
+	}{
+		{"missing path", &TopologyHistoryConfig{}, "Invalid topology_history.path"},
+		{"negative size", &TopologyHistoryConfig{Path: "history", MaxSizeMB: -1}, "Invalid topology_history.max_size_mb"},
+		{"bad max age", &TopologyHistoryConfig{Path: "history", MaxAge: "a month"}, "Invalid topology_history.max_age"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{
+				Server: &ServerConfig{
+					Listen: "0.0.0.0:7946",
+					UI:     "0.0.0.0:9000",
+				},
+				TopologyHistory: tt.history,
+			}
+			err := Validate(cfg)
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tt.summary)
+		})
+	}
+}
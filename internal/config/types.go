@@ -1,12 +1,160 @@
 package config
 
+import "github.com/hashicorp/hcl/v2"
+
 // Config represents the root Lattice configuration
 type Config struct {
-	Server *ServerConfig `hcl:"server,block"`
+	Server       *ServerConfig       `hcl:"server,block"`
+	Mesh         *MeshConfig         `hcl:"mesh,block"`
+	CORS         *CORSConfig         `hcl:"cors,block"`
+	LogStore     *LogStoreConfig     `hcl:"log_store,block"`
+	Metrics      *MetricsConfig      `hcl:"metrics,block"`
+	Prometheus   *PrometheusConfig   `hcl:"prometheus,block"`
+	PrometheusSD *PrometheusSDConfig `hcl:"prometheus_sd,block"`
+	DNS          *DNSConfig          `hcl:"dns,block"`
+
+	HealthChecks  []*HealthCheckConfig `hcl:"health_check,block"`
+	PassiveHealth *PassiveHealthConfig `hcl:"passive_health,block"`
+
+	TopologyHistory *TopologyHistoryConfig `hcl:"topology_history,block"`
+
+	// Body is retained so validation errors can point at the source range.
+	// Every block type has a Body field for the same reason.
+	Body hcl.Body `hcl:",body"`
 }
 
 // ServerConfig represents the server block
 type ServerConfig struct {
 	Listen string `hcl:"listen"`
 	UI     string `hcl:"ui"`
+
+	// Admin is the address of the admin API, which manages the gossip
+	// keyring. It defaults to a loopback address and should not be exposed.
+	Admin string `hcl:"admin,optional"`
+
+	// UIDir serves the web UI from a directory on disk instead of the
+	// embedded build, e.g. "./ui/dist" during development
+	UIDir string `hcl:"ui_dir,optional"`
+
+	Body hcl.Body `hcl:",body"`
+}
+
+// MeshConfig represents the mesh block, which tunes the Serf gossip mesh.
+// Bind settings default to the host and port of server.listen.
+type MeshConfig struct {
+	NodeName             string            `hcl:"node_name,optional"`
+	BindAddr             string            `hcl:"bind_addr,optional"`
+	BindPort             int               `hcl:"bind_port,optional"`
+	AdvertiseAddr        string            `hcl:"advertise_addr,optional"`
+	AdvertisePort        int               `hcl:"advertise_port,optional"`
+	Join                 []string          `hcl:"join,optional"`
+	RetryJoinInterval    string            `hcl:"retry_join_interval,optional"`
+	RetryJoinMaxAttempts int               `hcl:"retry_join_max_attempts,optional"`
+	Tags                 map[string]string `hcl:"tags,optional"`
+	Encrypt              string            `hcl:"encrypt,optional"`
+	KeyringFile          string            `hcl:"keyring_file,optional"`
+	TopologyTTL          string            `hcl:"topology_ttl,optional"`
+	Routing              string            `hcl:"routing,optional"`
+
+	Body hcl.Body `hcl:",body"`
+}
+
+// CORSConfig represents the cors block, which allows browsers on other
+// origins to call the API
+type CORSConfig struct {
+	AllowedOrigins   []string `hcl:"allowed_origins"`
+	AllowedMethods   []string `hcl:"allowed_methods,optional"`
+	AllowedHeaders   []string `hcl:"allowed_headers,optional"`
+	AllowCredentials bool     `hcl:"allow_credentials,optional"`
+	MaxAge           string   `hcl:"max_age,optional"`
+
+	Body hcl.Body `hcl:",body"`
+}
+
+// LogStoreConfig represents the log_store block, which retains request logs
+// collected from every service on disk
+type LogStoreConfig struct {
+	Path            string `hcl:"path"`
+	MaxSizeMB       int    `hcl:"max_size_mb,optional"`
+	MaxAge          string `hcl:"max_age,optional"`
+	CollectInterval string `hcl:"collect_interval,optional"`
+
+	Body hcl.Body `hcl:",body"`
+}
+
+// MetricsConfig represents the metrics block, which computes request rate,
+// error rate and latency percentiles per service from collected request logs
+type MetricsConfig struct {
+	Windows         []string `hcl:"windows,optional"`
+	SummaryWindow   string   `hcl:"summary_window,optional"`
+	MaxRoutes       int      `hcl:"max_routes,optional"`
+	CollectInterval string   `hcl:"collect_interval,optional"`
+
+	Body hcl.Body `hcl:",body"`
+}
+
+// PrometheusConfig represents the prometheus block, which serves Lattice and
+// service metrics for Prometheus to scrape on the UI listener
+type PrometheusConfig struct {
+	Path string `hcl:"path,optional"`
+
+	Body hcl.Body `hcl:",body"`
+}
+
+// PrometheusSDConfig represents the prometheus_sd block, which serves the
+// services in the topology as Prometheus http_sd_configs targets
+type PrometheusSDConfig struct {
+	Path    string            `hcl:"path,optional"`
+	Types   []string          `hcl:"types,optional"`
+	Tags    map[string]string `hcl:"tags,optional"`
+	Port    int               `hcl:"port,optional"`
+	PortMap map[string]int    `hcl:"port_map,optional"`
+
+	Body hcl.Body `hcl:",body"`
+}
+
+// DNSConfig represents the dns block, which answers DNS queries for the
+// healthy instances of services in the mesh
+type DNSConfig struct {
+	Listen string `hcl:"listen,optional"`
+	Domain string `hcl:"domain,optional"`
+	TTL    string `hcl:"ttl,optional"`
+
+	Body hcl.Body `hcl:",body"`
+}
+
+// HealthCheckConfig represents a health_check block, which actively probes
+// the instances of a service. A label of "*" applies the check to every
+// service without a health_check block of its own.
+type HealthCheckConfig struct {
+	Service            string `hcl:"service,label"`
+	Type               string `hcl:"type"`
+	Path               string `hcl:"path,optional"`
+	Interval           string `hcl:"interval,optional"`
+	Timeout            string `hcl:"timeout,optional"`
+	HealthyThreshold   int    `hcl:"healthy_threshold,optional"`
+	UnhealthyThreshold int    `hcl:"unhealthy_threshold,optional"`
+
+	Body hcl.Body `hcl:",body"`
+}
+
+// PassiveHealthConfig represents the passive_health block, which marks
+// services degraded or unhealthy from the error rate of their requests
+type PassiveHealthConfig struct {
+	Window             string  `hcl:"window,optional"`
+	MinRequests        int     `hcl:"min_requests,optional"`
+	DegradedErrorRate  float64 `hcl:"degraded_error_rate,optional"`
+	UnhealthyErrorRate float64 `hcl:"unhealthy_error_rate,optional"`
+
+	Body hcl.Body `hcl:",body"`
+}
+
+// TopologyHistoryConfig represents the topology_history block, which records
+// every topology revision on disk
+type TopologyHistoryConfig struct {
+	Path      string `hcl:"path"`
+	MaxSizeMB int    `hcl:"max_size_mb,optional"`
+	MaxAge    string `hcl:"max_age,optional"`
+
+	Body hcl.Body `hcl:",body"`
 }
@@ -0,0 +1,332 @@
+// Package health determines whether services are healthy from active
+// HTTP and TCP probes and from the error rate of their requests.
+package health
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Check types
+const (
+	TypeHTTP = "http"
+	TypeTCP  = "tcp"
+)
+
+// Defaults for unset Check fields
+const (
+	DefaultInterval           = 10 * time.Second
+	DefaultTimeout            = 2 * time.Second
+	DefaultHealthyThreshold   = 2
+	DefaultUnhealthyThreshold = 3
+)
+
+// Status is the health of a service, ordered from best to worst
+type Status int
+
+const (
+	StatusHealthy Status = iota
+	StatusDegraded
+	StatusUnhealthy
+)
+
+func (s Status) String() string {
+	switch s {
+	case StatusHealthy:
+		return "healthy"
+	case StatusDegraded:
+		return "degraded"
+	case StatusUnhealthy:
+		return "unhealthy"
+	default:
+		return fmt.Sprintf("Status(%d)", int(s))
+	}
+}
+
+// Result is the health of a service and why
+type Result struct {
+	Status Status
+
+	// Reason explains a status other than healthy
+	Reason string
+}
+
+// Worst returns the result with the worst status, or a healthy result if
+// there are none
+func Worst(results ...Result) Result {
+	var worst Result
+	for _, r := range results {
+		if r.Status > worst.Status {
+			worst = r
+		}
+	}
+	return worst
+}
+
+// Check configures an active health check
+type Check struct {
+	// Type is TypeHTTP or TypeTCP
+	Type string
+
+	// Path is requested by HTTP checks, "/" if empty. Any 2xx or 3xx
+	// response passes.
+	Path string
+
+	Interval time.Duration
+	Timeout  time.Duration
+
+	// HealthyThreshold is the number of consecutive passing checks before a
+	// failing service is healthy again
+	HealthyThreshold int
+
+	// UnhealthyThreshold is the number of consecutive failing checks before
+	// a service is unhealthy
+	UnhealthyThreshold int
+}
+
+// withDefaults returns the check with unset fields defaulted
+func (c Check) withDefaults() Check {
+	if c.Type == "" {
+		c.Type = TypeTCP
+	}
+	if c.Path == "" {
+		c.Path = "/"
+	}
+	if c.Interval <= 0 {
+		c.Interval = DefaultInterval
+	}
+	if c.Timeout <= 0 {
+		c.Timeout = DefaultTimeout
+	}
+	if c.HealthyThreshold <= 0 {
+		c.HealthyThreshold = DefaultHealthyThreshold
+	}
+	if c.UnhealthyThreshold <= 0 {
+		c.UnhealthyThreshold = DefaultUnhealthyThreshold
+	}
+	return c
+}
+
+// Target is a service instance to check
+type Target struct {
+	Service string
+	Node    string
+
+	// Address is the host:port to probe
+	Address string
+
+	Check Check
+}
+
+// targetKey identifies a target independent of its check
+type targetKey struct {
+	service string
+	node    string
+	address string
+}
+
+func (t Target) key() targetKey {
+	return targetKey{t.Service, t.Node, t.Address}
+}
+
+// Checker probes targets in the background and tracks their health
+type Checker struct {
+	client   *http.Client
+	onChange func()
+
+	mu     sync.Mutex
+	probes map[targetKey]*probe
+	wg     sync.WaitGroup
+}
+
+// probe is the running check of a single target
+type probe struct {
+	target Target
+	cancel context.CancelFunc
+
+	// Guarded by Checker.mu
+	known     bool // Set once the status is decided
+	status    Status
+	reason    string
+	successes int
+	failures  int
+}
+
+// NewChecker creates a Checker. onChange, if set, is called whenever the
+// status of a target changes.
+func NewChecker(onChange func()) *Checker {
+	return &Checker{
+		client: &http.Client{
+			// Redirects pass, there's no need to follow them
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		onChange: onChange,
+		probes:   make(map[targetKey]*probe),
+	}
+}
+
+// Sync starts checking new targets and stops checking targets that are no
+// longer present. Targets whose check changed are restarted.
+func (c *Checker) Sync(targets []Target) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	keep := make(map[targetKey]bool, len(targets))
+	for _, target := range targets {
+		target.Check = target.Check.withDefaults()
+		key := target.key()
+		keep[key] = true
+
+		if p, ok := c.probes[key]; ok {
+			if p.target.Check == target.Check {
+				continue
+			}
+			p.cancel()
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		p := &probe{target: target, cancel: cancel}
+		c.probes[key] = p
+
+		c.wg.Add(1)
+		go func() {
+			defer c.wg.Done()
+			c.run(ctx, p)
+		}()
+	}
+
+	for key, p := range c.probes {
+		if !keep[key] {
+			p.cancel()
+			delete(c.probes, key)
+		}
+	}
+}
+
+// Result returns the health of a target. ok is false if the target is not
+// checked or no status has been decided yet.
+func (c *Checker) Result(service, node, address string) (Result, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	p, ok := c.probes[targetKey{service, node, address}]
+	if !ok || !p.known {
+		return Result{}, false
+	}
+	return Result{Status: p.status, Reason: p.reason}, true
+}
+
+// Stop stops all checks and waits for them to finish
+func (c *Checker) Stop() {
+	c.Sync(nil)
+	c.wg.Wait()
+}
+
+// run checks a target every interval until ctx is done
+func (c *Checker) run(ctx context.Context, p *probe) {
+	check := p.target.Check
+
+	ticker := time.NewTicker(check.Interval)
+	defer ticker.Stop()
+
+	for {
+		checkCtx, cancel := context.WithTimeout(ctx, check.Timeout)
+		err := c.check(checkCtx, p.target)
+		cancel()
+
+		// The check was stopped, not failed
+		if ctx.Err() != nil {
+			return
+		}
+
+		if c.update(p, err) && c.onChange != nil {
+			c.onChange()
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// update records a check result and reports whether the status changed
+func (c *Checker) update(p *probe, err error) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	check := p.target.Check
+	prevKnown, prevStatus := p.known, p.status
+
+	if err == nil {
+		p.successes++
+		p.failures = 0
+
+		// A new target is healthy as soon as it passes, a failing one needs
+		// to pass repeatedly
+		if !p.known || p.successes >= check.HealthyThreshold {
+			p.known = true
+			p.status = StatusHealthy
+			p.reason = ""
+		}
+	} else {
+		p.failures++
+		p.successes = 0
+
+		if p.failures >= check.UnhealthyThreshold {
+			p.known = true
+			p.status = StatusUnhealthy
+			p.reason = fmt.Sprintf("%s check failed %d times: %s", check.Type, p.failures, err)
+		}
+	}
+
+	return p.known != prevKnown || p.status != prevStatus
+}
+
+// check probes a target once
+func (c *Checker) check(ctx context.Context, target Target) error {
+	switch target.Check.Type {
+	case TypeHTTP:
+		return c.checkHTTP(ctx, target)
+	case TypeTCP:
+		var d net.Dialer
+		conn, err := d.DialContext(ctx, "tcp", target.Address)
+		if err != nil {
+			return err
+		}
+		return conn.Close()
+	default:
+		return fmt.Errorf("unknown check type %q", target.Check.Type)
+	}
+}
+
+// checkHTTP passes if the check path responds with a 2xx or 3xx status
+func (c *Checker) checkHTTP(ctx context.Context, target Target) error {
+	url := "http://" + target.Address + target.Check.Path
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return fmt.Errorf("timed out after %s", target.Check.Timeout)
+		}
+		return err
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		return fmt.Errorf("status %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,133 @@
+package health
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jumppad-labs/lattice/internal/metrics"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckerHTTP(t *testing.T) {
+	var failing atomic.Bool
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/healthz", r.URL.Path)
+		if failing.Load() {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		http.Redirect(w, r, "/elsewhere", http.StatusFound)
+	}))
+	defer srv.Close()
+
+	var changes atomic.Int32
+	checker := NewChecker(func() { changes.Add(1) })
+	defer checker.Stop()
+
+	target := Target{
+		Service: "users",
+		Node:    "node1",
+		Address: strings.TrimPrefix(srv.URL, "http://"),
+		Check: Check{
+			Type:               TypeHTTP,
+			Path:               "/healthz",
+			Interval:           10 * time.Millisecond,
+			HealthyThreshold:   2,
+			UnhealthyThreshold: 2,
+		},
+	}
+	checker.Sync([]Target{target})
+
+	result := func() Result {
+		r, _ := checker.Result("users", "node1", target.Address)
+		return r
+	}
+
+	// Healthy on the first pass, redirects count as passing
+	require.Eventually(t, func() bool {
+		_, ok := checker.Result("users", "node1", target.Address)
+		return ok
+	}, time.Second, 5*time.Millisecond)
+	require.Equal(t, StatusHealthy, result().Status)
+
+	failing.Store(true)
+	require.Eventually(t, func() bool { return result().Status == StatusUnhealthy }, time.Second, 5*time.Millisecond)
+	require.Contains(t, result().Reason, "http check failed")
+	require.Contains(t, result().Reason, "status 500")
+
+	failing.Store(false)
+	require.Eventually(t, func() bool { return result().Status == StatusHealthy }, time.Second, 5*time.Millisecond)
+	require.Empty(t, result().Reason)
+	require.GreaterOrEqual(t, changes.Load(), int32(3))
+
+	// Removed targets are no longer checked
+	checker.Sync(nil)
+	_, ok := checker.Result("users", "node1", target.Address)
+	require.False(t, ok)
+}
+
+func TestCheckerTCP(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := ln.Addr().String()
+	ln.Close()
+
+	checker := NewChecker(nil)
+	defer checker.Stop()
+
+	checker.Sync([]Target{{
+		Service: "db",
+		Node:    "node1",
+		Address: addr,
+		Check:   Check{Type: TypeTCP, Interval: 10 * time.Millisecond, UnhealthyThreshold: 2},
+	}})
+
+	require.Eventually(t, func() bool {
+		r, ok := checker.Result("db", "node1", addr)
+		return ok && r.Status == StatusUnhealthy
+	}, time.Second, 5*time.Millisecond)
+
+	r, _ := checker.Result("db", "node1", addr)
+	require.Contains(t, r.Reason, "tcp check failed")
+}
+
+func TestPassiveEvaluate(t *testing.T) {
+	p := Passive{}.WithDefaults()
+
+	tests := []struct {
+		name   string
+		window metrics.Window
+		status Status
+	}{
+		{"too few requests", metrics.Window{Requests: 5, Errors: 5, ErrorRate: 1}, StatusHealthy},
+		{"low error rate", metrics.Window{Requests: 100, Errors: 1, ErrorRate: 0.01}, StatusHealthy},
+		{"degraded", metrics.Window{Requests: 100, Errors: 10, ErrorRate: 0.1}, StatusDegraded},
+		{"unhealthy", metrics.Window{Requests: 100, Errors: 60, ErrorRate: 0.6}, StatusUnhealthy},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := p.Evaluate(tt.window)
+			require.Equal(t, tt.status, r.Status)
+			if tt.status != StatusHealthy {
+				require.Contains(t, r.Reason, "requests failed")
+			}
+		})
+	}
+}
+
+func TestWorst(t *testing.T) {
+	require.Equal(t, Result{}, Worst())
+
+	worst := Worst(
+		Result{Status: StatusDegraded, Reason: "errors"},
+		Result{Status: StatusUnhealthy, Reason: "check failed"},
+		Result{},
+	)
+	require.Equal(t, "check failed", worst.Reason)
+}
@@ -0,0 +1,70 @@
+package health
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jumppad-labs/lattice/internal/metrics"
+)
+
+// Defaults for unset Passive fields
+const (
+	DefaultPassiveWindow      = time.Minute
+	DefaultMinRequests        = 20
+	DefaultDegradedErrorRate  = 0.05
+	DefaultUnhealthyErrorRate = 0.5
+)
+
+// Passive derives health from the error rate of a service's requests
+type Passive struct {
+	// Window is how far back requests are considered
+	Window time.Duration
+
+	// MinRequests is how many requests the window needs before the error
+	// rate is trusted
+	MinRequests int64
+
+	// DegradedErrorRate and UnhealthyErrorRate are the fractions of failed
+	// requests at which a service is degraded or unhealthy
+	DegradedErrorRate  float64
+	UnhealthyErrorRate float64
+}
+
+// WithDefaults returns p with unset fields defaulted
+func (p Passive) WithDefaults() Passive {
+	if p.Window <= 0 {
+		p.Window = DefaultPassiveWindow
+	}
+	if p.MinRequests <= 0 {
+		p.MinRequests = DefaultMinRequests
+	}
+	if p.DegradedErrorRate <= 0 {
+		p.DegradedErrorRate = DefaultDegradedErrorRate
+	}
+	if p.UnhealthyErrorRate <= 0 {
+		p.UnhealthyErrorRate = DefaultUnhealthyErrorRate
+	}
+	return p
+}
+
+// Evaluate returns the health indicated by the requests in w
+func (p Passive) Evaluate(w metrics.Window) Result {
+	if w.Requests < p.MinRequests {
+		return Result{}
+	}
+
+	var status Status
+	switch {
+	case w.ErrorRate >= p.UnhealthyErrorRate:
+		status = StatusUnhealthy
+	case w.ErrorRate >= p.DegradedErrorRate:
+		status = StatusDegraded
+	default:
+		return Result{}
+	}
+
+	return Result{
+		Status: status,
+		Reason: fmt.Sprintf("%.1f%% of %d requests failed in the last %s", w.ErrorRate*100, w.Requests, p.Window),
+	}
+}
@@ -0,0 +1,524 @@
+// Package history records every revision of the mesh topology in a size-
+// and age-bounded set of append-only segment files, so past topologies can
+// be inspected after the fact.
+package history
+
+import (
+	"bufio"
+	"cmp"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	observerv1 "github.com/jumppad-labs/lattice/pkg/api/observer/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	// segmentExt is the file extension of segment files
+	segmentExt = ".jsonl"
+
+	// minSegmentBytes is the smallest size a segment grows to before a new
+	// one is started
+	minSegmentBytes = 64 << 10
+
+	// maxSegmentBytes is the largest size a segment grows to
+	maxSegmentBytes = 64 << 20
+
+	// segmentsPerStore is roughly how many segments a full store is split
+	// into, so retention frees space in reasonably small steps
+	segmentsPerStore = 8
+)
+
+var (
+	// ErrNotFound is returned by At for a time before the oldest revision,
+	// and by Get for a revision that isn't recorded
+	ErrNotFound = errors.New("topology revision not found")
+
+	// ErrInvalidCursor is returned by Changes for a cursor it did not issue
+	ErrInvalidCursor = errors.New("invalid cursor")
+)
+
+// record is a revision as stored on disk. The full topology is kept next to
+// the delta so any revision can be read without replaying the log; the
+// topology only changes on joins, leaves and updates, so this stays small.
+type record struct {
+	Revision  uint64          `json:"rev"`
+	Timestamp int64           `json:"ts"` // Unix milliseconds
+	Topology  json.RawMessage `json:"topology"`
+	Delta     json.RawMessage `json:"delta"`
+}
+
+// Revision is a recorded topology revision
+type Revision struct {
+	Revision  uint64
+	Timestamp int64 // Unix milliseconds
+
+	// Topology is the topology after the revision. It is only set by At
+	// and Last.
+	Topology *observerv1.Topology
+
+	// Delta holds the changes from the previous revision
+	Delta *observerv1.TopologyDelta
+}
+
+// Options configures a Store
+type Options struct {
+	// Dir is the directory holding segment files. It is created if missing.
+	Dir string
+
+	// MaxBytes bounds the total size of all segments
+	MaxBytes int64
+
+	// MaxAge drops segments whose newest revision is older than this. Zero
+	// disables age-based retention.
+	MaxAge time.Duration
+}
+
+// segment is an append-only file of JSON-encoded records
+type segment struct {
+	path     string
+	firstRev uint64
+	lastRev  uint64
+	minTime  int64
+	maxTime  int64
+	size     int64
+}
+
+// Store is an on-disk topology history
+type Store struct {
+	opts         Options
+	segmentBytes int64
+
+	mu       sync.RWMutex
+	segments []*segment // Oldest first, the last one is active
+	file     *os.File
+	writer   *bufio.Writer
+	lastRev  uint64
+}
+
+// Open opens or creates a store. A new segment is always started so a
+// partially written record from a crash is never appended to.
+func Open(opts Options) (*Store, error) {
+	if opts.Dir == "" {
+		return nil, errors.New("history directory is required")
+	}
+	if opts.MaxBytes <= 0 {
+		return nil, errors.New("history max size must be positive")
+	}
+
+	if err := os.MkdirAll(opts.Dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create history directory: %w", err)
+	}
+
+	s := &Store{
+		opts:         opts,
+		segmentBytes: min(max(opts.MaxBytes/segmentsPerStore, minSegmentBytes), maxSegmentBytes),
+	}
+
+	if err := s.load(); err != nil {
+		return nil, err
+	}
+
+	if err := s.roll(); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// load scans existing segments to restore their bounds and the last revision
+func (s *Store) load() error {
+	matches, err := filepath.Glob(filepath.Join(s.opts.Dir, "*"+segmentExt))
+	if err != nil {
+		return fmt.Errorf("failed to list history segments: %w", err)
+	}
+
+	for _, path := range matches {
+		seg := &segment{path: path}
+		err := scanSegment(path, -1, func(rec *record) bool {
+			seg.add(rec)
+			return true
+		})
+		if err != nil {
+			return err
+		}
+
+		info, err := os.Stat(path)
+		if err != nil {
+			return fmt.Errorf("failed to stat history segment: %w", err)
+		}
+		seg.size = info.Size()
+
+		if seg.lastRev == 0 {
+			// Nothing readable in it
+			os.Remove(path)
+			continue
+		}
+
+		s.segments = append(s.segments, seg)
+		s.lastRev = max(s.lastRev, seg.lastRev)
+	}
+
+	slices.SortFunc(s.segments, func(a, b *segment) int {
+		return cmp.Compare(a.firstRev, b.firstRev)
+	})
+
+	return nil
+}
+
+// add extends the segment's bounds to include a record
+func (seg *segment) add(rec *record) {
+	if seg.firstRev == 0 {
+		seg.firstRev = rec.Revision
+		seg.minTime = rec.Timestamp
+		seg.maxTime = rec.Timestamp
+	}
+	seg.lastRev = rec.Revision
+	seg.minTime = min(seg.minTime, rec.Timestamp)
+	seg.maxTime = max(seg.maxTime, rec.Timestamp)
+}
+
+// roll closes the active segment and starts a new one. Caller must hold
+// s.mu or have exclusive access.
+func (s *Store) roll() error {
+	if err := s.closeActive(); err != nil {
+		return err
+	}
+
+	// Segment names are zero-padded first revisions, so they sort oldest first
+	path := filepath.Join(s.opts.Dir, fmt.Sprintf("%020d%s", s.lastRev+1, segmentExt))
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to create history segment: %w", err)
+	}
+
+	s.file = file
+	s.writer = bufio.NewWriter(file)
+	s.segments = append(s.segments, &segment{path: path})
+
+	return nil
+}
+
+// closeActive flushes and closes the active segment
+func (s *Store) closeActive() error {
+	if s.file == nil {
+		return nil
+	}
+
+	if err := s.writer.Flush(); err != nil {
+		return fmt.Errorf("failed to flush history segment: %w", err)
+	}
+	if err := s.file.Close(); err != nil {
+		return fmt.Errorf("failed to close history segment: %w", err)
+	}
+
+	s.file = nil
+	s.writer = nil
+
+	// Don't keep empty segments around
+	if active := s.segments[len(s.segments)-1]; active.lastRev == 0 {
+		os.Remove(active.path)
+		s.segments = s.segments[:len(s.segments)-1]
+	}
+
+	return nil
+}
+
+// Append records a revision and applies retention. Revisions must increase.
+func (s *Store) Append(revision uint64, topology *observerv1.Topology, delta *observerv1.TopologyDelta) error {
+	topologyJSON, err := protojson.Marshal(topology)
+	if err != nil {
+		return fmt.Errorf("failed to encode topology: %w", err)
+	}
+	deltaJSON, err := protojson.Marshal(delta)
+	if err != nil {
+		return fmt.Errorf("failed to encode topology delta: %w", err)
+	}
+
+	rec := &record{
+		Revision:  revision,
+		Timestamp: topology.GetTimestamp(),
+		Topology:  topologyJSON,
+		Delta:     deltaJSON,
+	}
+	line, err := json.Marshal(rec)
+	if err != nil {
+		return fmt.Errorf("failed to encode history record: %w", err)
+	}
+	line = append(line, '\n')
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.file == nil {
+		return errors.New("history is closed")
+	}
+	if revision <= s.lastRev {
+		return fmt.Errorf("revision %d is not after the last recorded revision %d", revision, s.lastRev)
+	}
+
+	if _, err := s.writer.Write(line); err != nil {
+		return fmt.Errorf("failed to write history record: %w", err)
+	}
+	// Readers only see complete lines
+	if err := s.writer.Flush(); err != nil {
+		return fmt.Errorf("failed to flush history segment: %w", err)
+	}
+
+	s.lastRev = revision
+
+	active := s.segments[len(s.segments)-1]
+	active.add(rec)
+	active.size += int64(len(line))
+
+	if active.size >= s.segmentBytes {
+		if err := s.roll(); err != nil {
+			return err
+		}
+	}
+
+	s.pruneLocked(time.Now())
+	return nil
+}
+
+// Prune removes segments beyond the size and age limits
+func (s *Store) Prune(now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.pruneLocked(now)
+}
+
+// pruneLocked removes the oldest segments until the store is within its
+// limits. The active segment is never removed. Caller must hold s.mu.
+func (s *Store) pruneLocked(now time.Time) {
+	var total int64
+	for _, seg := range s.segments {
+		total += seg.size
+	}
+
+	cutoff := int64(0)
+	if s.opts.MaxAge > 0 {
+		cutoff = now.Add(-s.opts.MaxAge).UnixMilli()
+	}
+
+	for len(s.segments) > 1 {
+		oldest := s.segments[0]
+		if total <= s.opts.MaxBytes && oldest.maxTime >= cutoff {
+			break
+		}
+
+		if err := os.Remove(oldest.path); err != nil && !os.IsNotExist(err) {
+			// Try again on the next prune
+			return
+		}
+		total -= oldest.size
+		s.segments = s.segments[1:]
+	}
+}
+
+// Close flushes and closes the store
+func (s *Store) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.closeActive()
+}
+
+// Last returns the most recent revision, or nil if none is recorded
+func (s *Store) Last() (*Revision, error) {
+	return s.find(func(rec *record) bool { return true })
+}
+
+// At returns the revision that was current at a time in Unix milliseconds,
+// which is the last one recorded at or before it
+func (s *Store) At(timestamp int64) (*Revision, error) {
+	rev, err := s.find(func(rec *record) bool { return rec.Timestamp <= timestamp })
+	if err == nil && rev == nil {
+		return nil, ErrNotFound
+	}
+	return rev, err
+}
+
+// Get returns a recorded revision
+func (s *Store) Get(revision uint64) (*Revision, error) {
+	rev, err := s.find(func(rec *record) bool { return rec.Revision == revision })
+	if err == nil && rev == nil {
+		return nil, ErrNotFound
+	}
+	return rev, err
+}
+
+// find returns the newest revision matching fn, or nil if there is none
+func (s *Store) find(fn func(*record) bool) (*Revision, error) {
+	segments := s.snapshot()
+
+	for i := len(segments) - 1; i >= 0; i-- {
+		var found *record
+		err := scanSegment(segments[i].path, segments[i].size, func(rec *record) bool {
+			if fn(rec) {
+				found = rec
+			}
+			return true
+		})
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return nil, err
+		}
+
+		if found != nil {
+			return decode(found, true)
+		}
+	}
+
+	return nil, nil
+}
+
+// Query selects revisions from the store
+type Query struct {
+	// Start and End bound revision timestamps in Unix milliseconds. Start is
+	// inclusive and End exclusive; zero leaves a side unbounded.
+	Start int64
+	End   int64
+
+	// Cursor continues a previous query. Empty starts from the oldest
+	// revision.
+	Cursor string
+
+	// Limit is the maximum number of revisions returned
+	Limit int
+}
+
+// Changes returns the deltas of matching revisions, oldest first, along
+// with a cursor for the next page, which is empty if there are no more
+func (s *Store) Changes(q Query) ([]*Revision, string, error) {
+	var after uint64
+	if q.Cursor != "" {
+		rev, err := strconv.ParseUint(q.Cursor, 10, 64)
+		if err != nil {
+			return nil, "", fmt.Errorf("%w %q", ErrInvalidCursor, q.Cursor)
+		}
+		after = rev
+	}
+
+	var (
+		results []*Revision
+		scanErr error
+	)
+	for _, seg := range s.snapshot() {
+		if seg.lastRev <= after ||
+			(q.Start != 0 && seg.maxTime < q.Start) ||
+			(q.End != 0 && seg.minTime >= q.End) {
+			continue
+		}
+
+		err := scanSegment(seg.path, seg.size, func(rec *record) bool {
+			if rec.Revision <= after ||
+				(q.Start != 0 && rec.Timestamp < q.Start) ||
+				(q.End != 0 && rec.Timestamp >= q.End) {
+				return true
+			}
+
+			rev, err := decode(rec, false)
+			if err != nil {
+				scanErr = err
+				return false
+			}
+			results = append(results, rev)
+			return len(results) <= q.Limit
+		})
+		if scanErr != nil {
+			return nil, "", scanErr
+		}
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return nil, "", err
+		}
+
+		if len(results) > q.Limit {
+			results = results[:q.Limit]
+			return results, strconv.FormatUint(results[len(results)-1].Revision, 10), nil
+		}
+	}
+
+	return results, "", nil
+}
+
+// snapshot copies the segment bounds so reads don't block appends
+func (s *Store) snapshot() []segment {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	segments := make([]segment, 0, len(s.segments))
+	for _, seg := range s.segments {
+		segments = append(segments, *seg)
+	}
+	return segments
+}
+
+// decode converts a record to a Revision, including the topology if asked
+func decode(rec *record, withTopology bool) (*Revision, error) {
+	rev := &Revision{
+		Revision:  rec.Revision,
+		Timestamp: rec.Timestamp,
+		Delta:     &observerv1.TopologyDelta{},
+	}
+
+	if err := protojson.Unmarshal(rec.Delta, rev.Delta); err != nil {
+		return nil, fmt.Errorf("failed to decode delta of revision %d: %w", rec.Revision, err)
+	}
+
+	if withTopology {
+		rev.Topology = &observerv1.Topology{}
+		if err := protojson.Unmarshal(rec.Topology, rev.Topology); err != nil {
+			return nil, fmt.Errorf("failed to decode topology of revision %d: %w", rec.Revision, err)
+		}
+	}
+
+	return rev, nil
+}
+
+// scanSegment decodes records from a segment, reading at most limit bytes
+// if limit is not negative. Lines that can't be decoded are skipped. The
+// scan stops when fn returns false.
+func scanSegment(path string, limit int64, fn func(*record) bool) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open history segment: %w", err)
+	}
+	defer file.Close()
+
+	var r io.Reader = file
+	if limit >= 0 {
+		r = io.LimitReader(file, limit)
+	}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64<<10), 16<<20)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		rec := &record{}
+		if err := json.Unmarshal([]byte(line), rec); err != nil || rec.Revision == 0 {
+			continue
+		}
+		if !fn(rec) {
+			return nil
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read history segment: %w", err)
+	}
+	return nil
+}
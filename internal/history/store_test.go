@@ -0,0 +1,166 @@
+package history
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	observerv1 "github.com/jumppad-labs/lattice/pkg/api/observer/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func openStore(t *testing.T, dir string, maxBytes int64) *Store {
+	t.Helper()
+
+	s, err := Open(Options{Dir: dir, MaxBytes: maxBytes})
+	require.NoError(t, err)
+	t.Cleanup(func() { s.Close() })
+	return s
+}
+
+// appendRevision records a topology with the named services at ts
+func appendRevision(t *testing.T, s *Store, rev uint64, ts int64, names ...string) {
+	t.Helper()
+
+	topology := &observerv1.Topology{Timestamp: ts}
+	for _, name := range names {
+		topology.Services = append(topology.Services, &observerv1.Service{Name: name, NodeName: "node1"})
+	}
+	delta := &observerv1.TopologyDelta{BaseRevision: rev - 1, Timestamp: ts}
+	if len(topology.Services) > 0 {
+		delta.Added = topology.Services[len(topology.Services)-1:]
+	}
+
+	require.NoError(t, s.Append(rev, topology, delta))
+}
+
+func serviceNames(topology *observerv1.Topology) []string {
+	var names []string
+	for _, svc := range topology.Services {
+		names = append(names, svc.Name)
+	}
+	return names
+}
+
+func TestStoreAt(t *testing.T) {
+	s := openStore(t, t.TempDir(), 1<<20)
+
+	last, err := s.Last()
+	require.NoError(t, err)
+	require.Nil(t, last)
+
+	appendRevision(t, s, 1, 1000, "api")
+	appendRevision(t, s, 2, 2000, "api", "db")
+	appendRevision(t, s, 3, 3000, "api", "db", "cache")
+
+	_, err = s.At(999)
+	require.ErrorIs(t, err, ErrNotFound)
+
+	rev, err := s.At(2500)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), rev.Revision)
+	require.Equal(t, []string{"api", "db"}, serviceNames(rev.Topology))
+
+	rev, err = s.At(3000)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), rev.Revision)
+
+	last, err = s.Last()
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), last.Revision)
+	require.Len(t, last.Topology.Services, 3)
+
+	rev, err = s.Get(1)
+	require.NoError(t, err)
+	require.Equal(t, []string{"api"}, serviceNames(rev.Topology))
+
+	_, err = s.Get(4)
+	require.ErrorIs(t, err, ErrNotFound)
+
+	// Revisions must increase
+	require.Error(t, s.Append(3, &observerv1.Topology{}, &observerv1.TopologyDelta{}))
+}
+
+func TestStoreChanges(t *testing.T) {
+	s := openStore(t, t.TempDir(), 1<<20)
+
+	for i := uint64(1); i <= 5; i++ {
+		appendRevision(t, s, i, int64(i)*1000, "svc")
+	}
+
+	changes, next, err := s.Changes(Query{Start: 2000, End: 5000, Limit: 2})
+	require.NoError(t, err)
+	require.Len(t, changes, 2)
+	require.Equal(t, uint64(2), changes[0].Revision)
+	require.Nil(t, changes[0].Topology)
+	require.Len(t, changes[0].Delta.Added, 1)
+	require.NotEmpty(t, next)
+
+	changes, next, err = s.Changes(Query{Start: 2000, End: 5000, Cursor: next, Limit: 2})
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	require.Equal(t, uint64(4), changes[0].Revision)
+	require.Empty(t, next)
+
+	_, _, err = s.Changes(Query{Cursor: "bogus", Limit: 10})
+	require.ErrorIs(t, err, ErrInvalidCursor)
+}
+
+func TestStoreReopen(t *testing.T) {
+	dir := t.TempDir()
+
+	s, err := Open(Options{Dir: dir, MaxBytes: 1 << 20})
+	require.NoError(t, err)
+	appendRevision(t, s, 1, 1000, "api")
+	appendRevision(t, s, 2, 2000, "api", "db")
+	require.NoError(t, s.Close())
+
+	// A partially written record is skipped
+	matches, err := filepath.Glob(filepath.Join(dir, "*"+segmentExt))
+	require.NoError(t, err)
+	require.Len(t, matches, 1)
+	f, err := os.OpenFile(matches[0], os.O_APPEND|os.O_WRONLY, 0o644)
+	require.NoError(t, err)
+	_, err = f.WriteString(`{"rev":3,"ts":30`)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	s = openStore(t, dir, 1<<20)
+	last, err := s.Last()
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), last.Revision)
+
+	appendRevision(t, s, 3, 3000, "api", "db", "cache")
+	rev, err := s.At(time.Now().UnixMilli())
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), rev.Revision)
+}
+
+func TestStoreRetention(t *testing.T) {
+	dir := t.TempDir()
+	s := openStore(t, dir, 4*minSegmentBytes)
+
+	// Pad services so segments fill quickly
+	padding := make([]string, 200)
+	for i := range padding {
+		padding[i] = "service-with-a-rather-long-name"
+	}
+	for i := uint64(1); i <= 200; i++ {
+		appendRevision(t, s, i, int64(i)*1000, padding...)
+	}
+
+	var total int64
+	for _, seg := range s.snapshot() {
+		total += seg.size
+	}
+	require.LessOrEqual(t, total, int64(4*minSegmentBytes))
+
+	// The oldest revisions are gone, the newest remain
+	_, err := s.At(1000)
+	require.ErrorIs(t, err, ErrNotFound)
+
+	last, err := s.Last()
+	require.NoError(t, err)
+	require.Equal(t, uint64(200), last.Revision)
+}
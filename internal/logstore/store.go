@@ -0,0 +1,444 @@
+// Package logstore persists request logs collected from the mesh in a
+// size- and age-bounded set of append-only segment files.
+package logstore
+
+import (
+	"bufio"
+	"cmp"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// segmentExt is the file extension of segment files
+	segmentExt = ".jsonl"
+
+	// minSegmentBytes is the smallest size a segment grows to before a new
+	// one is started
+	minSegmentBytes = 64 << 10
+
+	// maxSegmentBytes is the largest size a segment grows to
+	maxSegmentBytes = 64 << 20
+
+	// segmentsPerStore is roughly how many segments a full store is split
+	// into, so retention frees space in reasonably small steps
+	segmentsPerStore = 8
+)
+
+// ErrInvalidCursor is returned by Search for a cursor it did not issue
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// Entry is a request log stored with the service that logged it
+type Entry struct {
+	// ID is assigned by the store and increases with every entry appended
+	ID uint64 `json:"id"`
+
+	Service    string `json:"svc"`
+	Sequence   uint64 `json:"seq"`
+	Timestamp  int64  `json:"ts"` // Unix milliseconds
+	Method     string `json:"method"`
+	Path       string `json:"path"`
+	Status     int32  `json:"status"`
+	DurationMs int64  `json:"dur"`
+	Level      string `json:"level"`
+}
+
+// Options configures a Store
+type Options struct {
+	// Dir is the directory holding segment files. It is created if missing.
+	Dir string
+
+	// MaxBytes bounds the total size of all segments
+	MaxBytes int64
+
+	// MaxAge drops segments whose newest entry is older than this. Zero
+	// disables age-based retention.
+	MaxAge time.Duration
+}
+
+// segment is an append-only file of JSON-encoded entries
+type segment struct {
+	path    string
+	firstID uint64
+	lastID  uint64
+	minTime int64
+	maxTime int64
+	size    int64
+}
+
+// Store is an on-disk request log store
+type Store struct {
+	opts         Options
+	segmentBytes int64
+
+	mu       sync.RWMutex
+	segments []*segment // Oldest first, the last one is active
+	file     *os.File
+	writer   *bufio.Writer
+	nextID   uint64
+	lastSeq  map[string]uint64
+}
+
+// Open opens or creates a store. A new segment is always started so a
+// partially written entry from a crash is never appended to.
+func Open(opts Options) (*Store, error) {
+	if opts.Dir == "" {
+		return nil, errors.New("log store directory is required")
+	}
+	if opts.MaxBytes <= 0 {
+		return nil, errors.New("log store max size must be positive")
+	}
+
+	if err := os.MkdirAll(opts.Dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create log store directory: %w", err)
+	}
+
+	s := &Store{
+		opts:         opts,
+		segmentBytes: min(max(opts.MaxBytes/segmentsPerStore, minSegmentBytes), maxSegmentBytes),
+		nextID:       1,
+		lastSeq:      make(map[string]uint64),
+	}
+
+	if err := s.load(); err != nil {
+		return nil, err
+	}
+
+	if err := s.roll(); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// load scans existing segments to restore IDs, time bounds and the last
+// sequence stored per service
+func (s *Store) load() error {
+	matches, err := filepath.Glob(filepath.Join(s.opts.Dir, "*"+segmentExt))
+	if err != nil {
+		return fmt.Errorf("failed to list log segments: %w", err)
+	}
+
+	// Segment names are zero-padded first IDs, so they sort oldest first
+	for _, path := range matches {
+		seg := &segment{path: path}
+		err := scanSegment(path, -1, func(entry *Entry) bool {
+			seg.add(entry)
+			s.lastSeq[entry.Service] = entry.Sequence
+			return true
+		})
+		if err != nil {
+			return err
+		}
+
+		info, err := os.Stat(path)
+		if err != nil {
+			return fmt.Errorf("failed to stat log segment: %w", err)
+		}
+		seg.size = info.Size()
+
+		if seg.lastID == 0 {
+			// Nothing readable in it
+			os.Remove(path)
+			continue
+		}
+
+		s.segments = append(s.segments, seg)
+		s.nextID = max(s.nextID, seg.lastID+1)
+	}
+
+	slices.SortFunc(s.segments, func(a, b *segment) int {
+		return cmp.Compare(a.firstID, b.firstID)
+	})
+
+	return nil
+}
+
+// add extends the segment's bounds to include an entry
+func (seg *segment) add(entry *Entry) {
+	if seg.firstID == 0 {
+		seg.firstID = entry.ID
+		seg.minTime = entry.Timestamp
+		seg.maxTime = entry.Timestamp
+	}
+	seg.lastID = entry.ID
+	seg.minTime = min(seg.minTime, entry.Timestamp)
+	seg.maxTime = max(seg.maxTime, entry.Timestamp)
+}
+
+// roll closes the active segment and starts a new one. Caller must hold
+// s.mu or have exclusive access.
+func (s *Store) roll() error {
+	if err := s.closeActive(); err != nil {
+		return err
+	}
+
+	path := filepath.Join(s.opts.Dir, fmt.Sprintf("%020d%s", s.nextID, segmentExt))
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to create log segment: %w", err)
+	}
+
+	s.file = file
+	s.writer = bufio.NewWriter(file)
+	s.segments = append(s.segments, &segment{path: path})
+
+	return nil
+}
+
+// closeActive flushes and closes the active segment
+func (s *Store) closeActive() error {
+	if s.file == nil {
+		return nil
+	}
+
+	if err := s.writer.Flush(); err != nil {
+		return fmt.Errorf("failed to flush log segment: %w", err)
+	}
+	if err := s.file.Close(); err != nil {
+		return fmt.Errorf("failed to close log segment: %w", err)
+	}
+
+	s.file = nil
+	s.writer = nil
+
+	// Don't keep empty segments around
+	if active := s.segments[len(s.segments)-1]; active.lastID == 0 {
+		os.Remove(active.path)
+		s.segments = s.segments[:len(s.segments)-1]
+	}
+
+	return nil
+}
+
+// Append stores entries, assigning their IDs, and applies retention
+func (s *Store) Append(entries []Entry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.file == nil {
+		return errors.New("log store is closed")
+	}
+
+	for i := range entries {
+		entry := &entries[i]
+		entry.ID = s.nextID
+
+		line, err := json.Marshal(entry)
+		if err != nil {
+			return fmt.Errorf("failed to encode log entry: %w", err)
+		}
+		line = append(line, '\n')
+
+		if _, err := s.writer.Write(line); err != nil {
+			return fmt.Errorf("failed to write log entry: %w", err)
+		}
+
+		s.nextID++
+		s.lastSeq[entry.Service] = entry.Sequence
+
+		active := s.segments[len(s.segments)-1]
+		active.add(entry)
+		active.size += int64(len(line))
+
+		if active.size >= s.segmentBytes {
+			if err := s.roll(); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Readers only see complete lines
+	if err := s.writer.Flush(); err != nil {
+		return fmt.Errorf("failed to flush log segment: %w", err)
+	}
+
+	s.pruneLocked(time.Now())
+	return nil
+}
+
+// Prune removes segments beyond the size and age limits
+func (s *Store) Prune(now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.pruneLocked(now)
+}
+
+// pruneLocked removes the oldest segments until the store is within its
+// limits. The active segment is never removed. Caller must hold s.mu.
+func (s *Store) pruneLocked(now time.Time) {
+	var total int64
+	for _, seg := range s.segments {
+		total += seg.size
+	}
+
+	cutoff := int64(0)
+	if s.opts.MaxAge > 0 {
+		cutoff = now.Add(-s.opts.MaxAge).UnixMilli()
+	}
+
+	for len(s.segments) > 1 {
+		oldest := s.segments[0]
+		if total <= s.opts.MaxBytes && oldest.maxTime >= cutoff {
+			break
+		}
+
+		if err := os.Remove(oldest.path); err != nil && !os.IsNotExist(err) {
+			// Try again on the next prune
+			return
+		}
+		total -= oldest.size
+		s.segments = s.segments[1:]
+	}
+}
+
+// LastSequence returns the last sequence stored for a service
+func (s *Store) LastSequence(service string) (uint64, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	seq, ok := s.lastSeq[service]
+	return seq, ok
+}
+
+// Close flushes and closes the store
+func (s *Store) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.closeActive()
+}
+
+// Query selects entries from the store
+type Query struct {
+	// Services limits results to these services. Empty matches all.
+	Services []string
+
+	// Start and End bound entry timestamps in Unix milliseconds. Start is
+	// inclusive and End exclusive; zero leaves a side unbounded.
+	Start int64
+	End   int64
+
+	// Match further filters entries. Nil matches all.
+	Match func(*Entry) bool
+
+	// Cursor continues a previous search. Empty starts from the oldest entry.
+	Cursor string
+
+	// Limit is the maximum number of entries returned
+	Limit int
+}
+
+// Search returns matching entries in the order they were stored, along
+// with a cursor for the next page, which is empty if there are no more
+func (s *Store) Search(q Query) ([]*Entry, string, error) {
+	var after uint64
+	if q.Cursor != "" {
+		id, err := strconv.ParseUint(q.Cursor, 10, 64)
+		if err != nil {
+			return nil, "", fmt.Errorf("%w %q", ErrInvalidCursor, q.Cursor)
+		}
+		after = id
+	}
+
+	// Snapshot segment bounds so reads don't block appends
+	s.mu.RLock()
+	segments := make([]segment, 0, len(s.segments))
+	for _, seg := range s.segments {
+		segments = append(segments, *seg)
+	}
+	s.mu.RUnlock()
+
+	var results []*Entry
+	for _, seg := range segments {
+		if seg.lastID <= after ||
+			(q.Start != 0 && seg.maxTime < q.Start) ||
+			(q.End != 0 && seg.minTime >= q.End) {
+			continue
+		}
+
+		err := scanSegment(seg.path, seg.size, func(entry *Entry) bool {
+			if entry.ID <= after || !q.matches(entry) {
+				return true
+			}
+			results = append(results, entry)
+			return len(results) <= q.Limit
+		})
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return nil, "", err
+		}
+
+		if len(results) > q.Limit {
+			results = results[:q.Limit]
+			return results, strconv.FormatUint(results[len(results)-1].ID, 10), nil
+		}
+	}
+
+	return results, "", nil
+}
+
+// matches reports whether an entry satisfies the query's filters
+func (q *Query) matches(entry *Entry) bool {
+	if len(q.Services) > 0 && !slices.Contains(q.Services, entry.Service) {
+		return false
+	}
+	if q.Start != 0 && entry.Timestamp < q.Start {
+		return false
+	}
+	if q.End != 0 && entry.Timestamp >= q.End {
+		return false
+	}
+	return q.Match == nil || q.Match(entry)
+}
+
+// scanSegment decodes entries from a segment, reading at most limit bytes
+// if limit is not negative. Lines that can't be decoded are skipped. The
+// scan stops when fn returns false.
+func scanSegment(path string, limit int64, fn func(*Entry) bool) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open log segment: %w", err)
+	}
+	defer file.Close()
+
+	var r io.Reader = file
+	if limit >= 0 {
+		r = io.LimitReader(file, limit)
+	}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64<<10), 1<<20)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		entry := &Entry{}
+		if err := json.Unmarshal([]byte(line), entry); err != nil {
+			continue
+		}
+		if !fn(entry) {
+			return nil
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read log segment: %w", err)
+	}
+	return nil
+}
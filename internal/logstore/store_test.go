@@ -0,0 +1,165 @@
+package logstore
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// testEntries returns n entries for a service with increasing sequences
+// and timestamps starting at ts
+func testEntries(service string, n int, ts int64) []Entry {
+	entries := make([]Entry, 0, n)
+	for i := range n {
+		entries = append(entries, Entry{
+			Service:   service,
+			Sequence:  uint64(i + 1),
+			Timestamp: ts + int64(i),
+			Method:    "GET",
+			Path:      "/",
+			Status:    200,
+		})
+	}
+	return entries
+}
+
+func TestOpenValidation(t *testing.T) {
+	_, err := Open(Options{MaxBytes: 1 << 20})
+	require.Error(t, err)
+
+	_, err = Open(Options{Dir: t.TempDir()})
+	require.Error(t, err)
+}
+
+func TestStoreSearch(t *testing.T) {
+	store, err := Open(Options{Dir: t.TempDir(), MaxBytes: 1 << 20})
+	require.NoError(t, err)
+	defer store.Close()
+
+	require.NoError(t, store.Append(testEntries("api", 5, 1000)))
+	require.NoError(t, store.Append(testEntries("orders", 5, 1002)))
+
+	// Everything, in the order stored
+	entries, cursor, err := store.Search(Query{Limit: 100})
+	require.NoError(t, err)
+	require.Len(t, entries, 10)
+	require.Empty(t, cursor)
+	require.Equal(t, uint64(1), entries[0].ID)
+	require.Equal(t, uint64(10), entries[9].ID)
+
+	// Service and time range
+	entries, _, err = store.Search(Query{Services: []string{"orders"}, Start: 1003, End: 1005, Limit: 100})
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	require.Equal(t, int64(1003), entries[0].Timestamp)
+	require.Equal(t, int64(1004), entries[1].Timestamp)
+
+	// Custom match
+	entries, _, err = store.Search(Query{Match: func(e *Entry) bool { return e.Sequence == 3 }, Limit: 100})
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+
+	// Pagination
+	var pages [][]*Entry
+	cursor = ""
+	for {
+		entries, next, err := store.Search(Query{Cursor: cursor, Limit: 4})
+		require.NoError(t, err)
+		pages = append(pages, entries)
+		if next == "" {
+			break
+		}
+		cursor = next
+	}
+	require.Len(t, pages, 3)
+	require.Len(t, pages[2], 2)
+	require.Equal(t, uint64(5), pages[1][0].ID)
+
+	_, _, err = store.Search(Query{Cursor: "bogus", Limit: 10})
+	require.ErrorIs(t, err, ErrInvalidCursor)
+}
+
+func TestStoreReopen(t *testing.T) {
+	dir := t.TempDir()
+
+	store, err := Open(Options{Dir: dir, MaxBytes: 1 << 20})
+	require.NoError(t, err)
+	require.NoError(t, store.Append(testEntries("api", 3, 1000)))
+	require.NoError(t, store.Close())
+
+	// A partially written line from a crash is skipped
+	matches, err := filepath.Glob(filepath.Join(dir, "*"+segmentExt))
+	require.NoError(t, err)
+	require.Len(t, matches, 1)
+	f, err := os.OpenFile(matches[0], os.O_APPEND|os.O_WRONLY, 0o644)
+	require.NoError(t, err)
+	_, err = f.WriteString(`{"id":4,"svc":"api`)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	store, err = Open(Options{Dir: dir, MaxBytes: 1 << 20})
+	require.NoError(t, err)
+	defer store.Close()
+
+	seq, ok := store.LastSequence("api")
+	require.True(t, ok)
+	require.Equal(t, uint64(3), seq)
+
+	_, ok = store.LastSequence("orders")
+	require.False(t, ok)
+
+	// IDs continue after the stored entries
+	require.NoError(t, store.Append(testEntries("orders", 1, 2000)))
+	entries, _, err := store.Search(Query{Limit: 100})
+	require.NoError(t, err)
+	require.Len(t, entries, 4)
+	require.Equal(t, uint64(4), entries[3].ID)
+	require.Equal(t, "orders", entries[3].Service)
+}
+
+func TestStoreRetentionBySize(t *testing.T) {
+	store, err := Open(Options{Dir: t.TempDir(), MaxBytes: 256 << 10})
+	require.NoError(t, err)
+	defer store.Close()
+
+	// Each entry is roughly 100 bytes, write about 1MB
+	for range 10 {
+		require.NoError(t, store.Append(testEntries("api", 1000, time.Now().UnixMilli())))
+	}
+
+	var total int64
+	for _, seg := range store.segments {
+		total += seg.size
+	}
+	require.LessOrEqual(t, total, int64(256<<10)+store.segmentBytes)
+
+	// The oldest entries are gone, the newest are kept
+	entries, _, err := store.Search(Query{Limit: 1})
+	require.NoError(t, err)
+	require.Greater(t, entries[0].ID, uint64(1))
+}
+
+func TestStoreRetentionByAge(t *testing.T) {
+	store, err := Open(Options{Dir: t.TempDir(), MaxBytes: 1 << 30, MaxAge: time.Hour})
+	require.NoError(t, err)
+	defer store.Close()
+
+	old := time.Now().Add(-2 * time.Hour).UnixMilli()
+	require.NoError(t, store.Append(testEntries("api", 10, old)))
+
+	// Start a new segment so the old one is no longer active
+	store.mu.Lock()
+	require.NoError(t, store.roll())
+	store.mu.Unlock()
+	require.NoError(t, store.Append(testEntries("api", 10, time.Now().UnixMilli())))
+
+	store.Prune(time.Now())
+
+	entries, _, err := store.Search(Query{Limit: 100})
+	require.NoError(t, err)
+	require.Len(t, entries, 10)
+	require.Equal(t, uint64(11), entries[0].ID)
+}
@@ -0,0 +1,318 @@
+// Package meshdns serves DNS records for services in the mesh, so clients
+// outside Lattice can discover them by name.
+//
+// Services resolve as <service>.service.<domain> (A, AAAA and SRV) and as
+// _<service>._tcp.service.<domain> (SRV). SRV targets are named
+// <hex address>.addr.<domain> and resolve to that address.
+package meshdns
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"math/rand/v2"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/miekg/dns"
+)
+
+const (
+	// DefaultDomain is the zone served when Options.Domain is unset
+	DefaultDomain = "lattice."
+
+	// DefaultTTL is the record TTL when Options.TTL is unset
+	DefaultTTL = 5 * time.Second
+)
+
+// Instance is a reachable instance of a service
+type Instance struct {
+	Node string
+	IP   net.IP
+	Port uint16
+}
+
+// InstanceFunc returns the healthy instances of a service. Names are
+// matched case-insensitively. ok is false if the service is not in the
+// mesh at all.
+type InstanceFunc func(service string) (instances []Instance, ok bool)
+
+// Options configures a Server
+type Options struct {
+	// Addr is the UDP and TCP address to listen on
+	Addr string
+
+	// Domain is the zone to answer for, DefaultDomain if empty
+	Domain string
+
+	// TTL is set on every record. Zero disables caching.
+	TTL time.Duration
+}
+
+// Server answers DNS queries for services in the mesh
+type Server struct {
+	domain    string
+	ttl       uint32
+	instances InstanceFunc
+
+	addr string
+	udp  *dns.Server
+	tcp  *dns.Server
+	wg   sync.WaitGroup
+}
+
+// NewServer creates a Server that looks up instances with fn
+func NewServer(opts Options, fn InstanceFunc) (*Server, error) {
+	domain := dns.Fqdn(strings.ToLower(opts.Domain))
+	if opts.Domain == "" {
+		domain = DefaultDomain
+	}
+	if _, ok := dns.IsDomainName(domain); !ok {
+		return nil, fmt.Errorf("invalid domain %q", opts.Domain)
+	}
+	if opts.TTL < 0 {
+		return nil, errors.New("ttl must not be negative")
+	}
+
+	return &Server{
+		domain:    domain,
+		ttl:       uint32(opts.TTL / time.Second),
+		instances: fn,
+		addr:      opts.Addr,
+	}, nil
+}
+
+// Start listens on UDP and TCP and serves queries in the background
+func (s *Server) Start() error {
+	pc, err := net.ListenPacket("udp", s.addr)
+	if err != nil {
+		return fmt.Errorf("failed to listen on udp %s: %w", s.addr, err)
+	}
+
+	// Use the same port for TCP if the OS picked one
+	ln, err := net.Listen("tcp", pc.LocalAddr().String())
+	if err != nil {
+		pc.Close()
+		return fmt.Errorf("failed to listen on tcp %s: %w", s.addr, err)
+	}
+
+	s.udp = &dns.Server{PacketConn: pc, Handler: s}
+	s.tcp = &dns.Server{Listener: ln, Handler: s}
+
+	for _, srv := range []*dns.Server{s.udp, s.tcp} {
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			if err := srv.ActivateAndServe(); err != nil {
+				log.Printf("DNS server error: %v", err)
+			}
+		}()
+	}
+
+	return nil
+}
+
+// Addr returns the address the server is listening on
+func (s *Server) Addr() string {
+	if s.udp == nil {
+		return s.addr
+	}
+	return s.udp.PacketConn.LocalAddr().String()
+}
+
+// Stop shuts down the listeners and waits for them to finish
+func (s *Server) Stop() error {
+	var errs []error
+	for _, srv := range []*dns.Server{s.udp, s.tcp} {
+		if srv != nil {
+			errs = append(errs, srv.Shutdown())
+		}
+	}
+	s.wg.Wait()
+	return errors.Join(errs...)
+}
+
+// ServeDNS answers a query
+func (s *Server) ServeDNS(w dns.ResponseWriter, req *dns.Msg) {
+	resp := s.answer(req)
+
+	// Fit UDP responses in what the client can receive
+	if _, ok := w.RemoteAddr().(*net.UDPAddr); ok {
+		size := dns.MinMsgSize
+		if opt := req.IsEdns0(); opt != nil {
+			size = int(opt.UDPSize())
+		}
+		resp.Truncate(size)
+	}
+
+	if err := w.WriteMsg(resp); err != nil {
+		log.Printf("Failed to write DNS response: %v", err)
+	}
+}
+
+// answer builds the response to a query
+func (s *Server) answer(req *dns.Msg) *dns.Msg {
+	resp := new(dns.Msg)
+	resp.SetReply(req)
+	resp.Authoritative = true
+	resp.RecursionAvailable = false
+
+	if len(req.Question) != 1 {
+		resp.SetRcode(req, dns.RcodeFormatError)
+		return resp
+	}
+	q := req.Question[0]
+	name := strings.ToLower(q.Name)
+
+	if !dns.IsSubDomain(s.domain, name) {
+		// Not our zone, and we don't recurse
+		resp.Authoritative = false
+		resp.SetRcode(req, dns.RcodeRefused)
+		return resp
+	}
+
+	labels := dns.SplitDomainName(strings.TrimSuffix(name, s.domain))
+	switch {
+	case len(labels) == 0:
+		s.answerApex(resp, q)
+	case len(labels) == 2 && labels[1] == "addr":
+		s.answerAddr(resp, q, labels[0])
+	case len(labels) >= 2 && labels[len(labels)-1] == "service":
+		s.answerService(resp, q, labels[:len(labels)-1])
+	default:
+		resp.Rcode = dns.RcodeNameError
+	}
+
+	if len(resp.Answer) == 0 {
+		// Negative answers carry the SOA so resolvers know how long to cache
+		resp.Ns = append(resp.Ns, s.soa())
+	}
+
+	return resp
+}
+
+// answerApex answers queries for the zone itself
+func (s *Server) answerApex(resp *dns.Msg, q dns.Question) {
+	switch q.Qtype {
+	case dns.TypeSOA, dns.TypeANY:
+		resp.Answer = append(resp.Answer, s.soa())
+	}
+}
+
+// answerService answers queries for <service>.service or
+// _<service>._tcp.service
+func (s *Server) answerService(resp *dns.Msg, q dns.Question, labels []string) {
+	var service string
+	switch {
+	case len(labels) == 1:
+		service = labels[0]
+	case len(labels) == 2 && strings.HasPrefix(labels[0], "_") && labels[1] == "_tcp":
+		if q.Qtype != dns.TypeSRV && q.Qtype != dns.TypeANY {
+			return
+		}
+		service = strings.TrimPrefix(labels[0], "_")
+	default:
+		resp.Rcode = dns.RcodeNameError
+		return
+	}
+
+	instances, ok := s.instances(service)
+	if !ok || len(instances) == 0 {
+		resp.Rcode = dns.RcodeNameError
+		return
+	}
+
+	// Spread clients across instances
+	rand.Shuffle(len(instances), func(i, j int) {
+		instances[i], instances[j] = instances[j], instances[i]
+	})
+
+	for _, inst := range instances {
+		switch q.Qtype {
+		case dns.TypeA, dns.TypeAAAA, dns.TypeANY:
+			if rr := s.addrRecord(q.Name, q.Qtype, inst.IP); rr != nil {
+				resp.Answer = append(resp.Answer, rr)
+			}
+		}
+
+		switch q.Qtype {
+		case dns.TypeSRV, dns.TypeANY:
+			target := addrName(inst.IP) + ".addr." + s.domain
+			resp.Answer = append(resp.Answer, &dns.SRV{
+				Hdr:      s.header(q.Name, dns.TypeSRV),
+				Priority: 1,
+				Weight:   1,
+				Port:     inst.Port,
+				Target:   target,
+			})
+
+			// Save clients a lookup of the target
+			if rr := s.addrRecord(target, dns.TypeANY, inst.IP); rr != nil {
+				resp.Extra = append(resp.Extra, rr)
+			}
+		}
+	}
+}
+
+// answerAddr answers queries for SRV targets, which encode their address
+func (s *Server) answerAddr(resp *dns.Msg, q dns.Question, label string) {
+	b, err := hex.DecodeString(label)
+	if err != nil || (len(b) != net.IPv4len && len(b) != net.IPv6len) {
+		resp.Rcode = dns.RcodeNameError
+		return
+	}
+
+	if rr := s.addrRecord(q.Name, q.Qtype, net.IP(b)); rr != nil {
+		resp.Answer = append(resp.Answer, rr)
+	}
+}
+
+// addrRecord returns an A or AAAA record for ip if it matches qtype, where
+// TypeANY matches both
+func (s *Server) addrRecord(name string, qtype uint16, ip net.IP) dns.RR {
+	if ip4 := ip.To4(); ip4 != nil {
+		if qtype == dns.TypeA || qtype == dns.TypeANY {
+			return &dns.A{Hdr: s.header(name, dns.TypeA), A: ip4}
+		}
+		return nil
+	}
+	if qtype == dns.TypeAAAA || qtype == dns.TypeANY {
+		return &dns.AAAA{Hdr: s.header(name, dns.TypeAAAA), AAAA: ip}
+	}
+	return nil
+}
+
+// soa returns the SOA record of the zone
+func (s *Server) soa() dns.RR {
+	return &dns.SOA{
+		Hdr:     s.header(s.domain, dns.TypeSOA),
+		Ns:      "ns." + s.domain,
+		Mbox:    "hostmaster." + s.domain,
+		Serial:  uint32(time.Now().Unix()),
+		Refresh: 3600,
+		Retry:   600,
+		Expire:  86400,
+		Minttl:  s.ttl,
+	}
+}
+
+// header returns a record header for the zone's TTL
+func (s *Server) header(name string, rrtype uint16) dns.RR_Header {
+	return dns.RR_Header{
+		Name:   name,
+		Rrtype: rrtype,
+		Class:  dns.ClassINET,
+		Ttl:    s.ttl,
+	}
+}
+
+// addrName encodes an address as a DNS label
+func addrName(ip net.IP) string {
+	if ip4 := ip.To4(); ip4 != nil {
+		return hex.EncodeToString(ip4)
+	}
+	return hex.EncodeToString(ip.To16())
+}
@@ -0,0 +1,128 @@
+package meshdns
+
+import (
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/require"
+)
+
+// testInstances serves a fixed set of services
+func testInstances(service string) ([]Instance, bool) {
+	switch strings.ToLower(service) {
+	case "users":
+		return []Instance{
+			{Node: "node1", IP: net.ParseIP("10.0.0.1"), Port: 8080},
+			{Node: "node2", IP: net.ParseIP("fd00::2"), Port: 8081},
+		}, true
+	case "down":
+		return nil, true
+	}
+	return nil, false
+}
+
+func startServer(t *testing.T) *Server {
+	t.Helper()
+
+	srv, err := NewServer(Options{Addr: "127.0.0.1:0", Domain: "Mesh.Test", TTL: 30 * time.Second}, testInstances)
+	require.NoError(t, err)
+	require.NoError(t, srv.Start())
+	t.Cleanup(func() { srv.Stop() })
+
+	return srv
+}
+
+func query(t *testing.T, srv *Server, network, name string, qtype uint16) *dns.Msg {
+	t.Helper()
+
+	req := new(dns.Msg)
+	req.SetQuestion(name, qtype)
+
+	client := &dns.Client{Net: network, Timeout: 2 * time.Second}
+	resp, _, err := client.Exchange(req, srv.Addr())
+	require.NoError(t, err)
+	return resp
+}
+
+func TestNewServerValidation(t *testing.T) {
+	_, err := NewServer(Options{Domain: "bad..domain"}, testInstances)
+	require.Error(t, err)
+
+	_, err = NewServer(Options{TTL: -time.Second}, testInstances)
+	require.Error(t, err)
+
+	srv, err := NewServer(Options{}, testInstances)
+	require.NoError(t, err)
+	require.Equal(t, DefaultDomain, srv.domain)
+}
+
+func TestServerAddressRecords(t *testing.T) {
+	srv := startServer(t)
+
+	resp := query(t, srv, "udp", "users.service.mesh.test.", dns.TypeA)
+	require.Equal(t, dns.RcodeSuccess, resp.Rcode)
+	require.True(t, resp.Authoritative)
+	require.Len(t, resp.Answer, 1)
+	a := resp.Answer[0].(*dns.A)
+	require.Equal(t, "10.0.0.1", a.A.String())
+	require.Equal(t, uint32(30), a.Hdr.Ttl)
+
+	// Names are case-insensitive, and work over TCP
+	resp = query(t, srv, "tcp", "USERS.service.Mesh.Test.", dns.TypeAAAA)
+	require.Len(t, resp.Answer, 1)
+	require.Equal(t, "fd00::2", resp.Answer[0].(*dns.AAAA).AAAA.String())
+}
+
+func TestServerSRVRecords(t *testing.T) {
+	srv := startServer(t)
+
+	for _, name := range []string{"users.service.mesh.test.", "_users._tcp.service.mesh.test."} {
+		resp := query(t, srv, "udp", name, dns.TypeSRV)
+		require.Equal(t, dns.RcodeSuccess, resp.Rcode)
+		require.Len(t, resp.Answer, 2)
+		require.Len(t, resp.Extra, 2)
+
+		ports := map[string]uint16{}
+		for _, rr := range resp.Answer {
+			record := rr.(*dns.SRV)
+			ports[record.Target] = record.Port
+		}
+		require.Equal(t, map[string]uint16{
+			"0a000001.addr.mesh.test.":                         8080,
+			"fd000000000000000000000000000002.addr.mesh.test.": 8081,
+		}, ports)
+	}
+
+	// SRV targets resolve
+	resp := query(t, srv, "udp", "0a000001.addr.mesh.test.", dns.TypeA)
+	require.Len(t, resp.Answer, 1)
+	require.Equal(t, "10.0.0.1", resp.Answer[0].(*dns.A).A.String())
+}
+
+func TestServerNegativeAnswers(t *testing.T) {
+	srv := startServer(t)
+
+	// Unknown services and services without healthy instances don't exist
+	for _, name := range []string{"missing.service.mesh.test.", "down.service.mesh.test.", "users.bogus.mesh.test."} {
+		resp := query(t, srv, "udp", name, dns.TypeA)
+		require.Equal(t, dns.RcodeNameError, resp.Rcode, name)
+		require.Len(t, resp.Ns, 1)
+		require.IsType(t, &dns.SOA{}, resp.Ns[0])
+	}
+
+	// Existing name, but no record of that type
+	resp := query(t, srv, "udp", "0a000001.addr.mesh.test.", dns.TypeAAAA)
+	require.Equal(t, dns.RcodeSuccess, resp.Rcode)
+	require.Empty(t, resp.Answer)
+	require.Len(t, resp.Ns, 1)
+
+	// Names outside the zone are refused
+	resp = query(t, srv, "udp", "example.com.", dns.TypeA)
+	require.Equal(t, dns.RcodeRefused, resp.Rcode)
+
+	resp = query(t, srv, "udp", "mesh.test.", dns.TypeSOA)
+	require.Len(t, resp.Answer, 1)
+}
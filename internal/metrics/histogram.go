@@ -0,0 +1,100 @@
+package metrics
+
+import (
+	"math"
+	"slices"
+	"time"
+)
+
+const (
+	// binsPerDoubling sets the histogram precision: each bin covers a
+	// latency range about 19% wide
+	binsPerDoubling = 4
+
+	// maxBin is the last bin, holding everything from about 17 minutes up
+	maxBin = 20*binsPerDoubling + 1
+)
+
+// histogram counts latencies in exponentially sized bins. Bin 0 holds
+// latencies under 1ms and bin i latencies from 2^((i-1)/4) to 2^(i/4)
+// milliseconds. It is sparse since most buckets only see a few distinct
+// latencies.
+type histogram map[int]int64
+
+// add counts a latency
+func (h *histogram) add(d time.Duration) {
+	if *h == nil {
+		*h = make(histogram)
+	}
+	(*h)[latencyBin(d)]++
+}
+
+// merge adds the counts of another histogram
+func (h *histogram) merge(other histogram) {
+	if len(other) == 0 {
+		return
+	}
+	if *h == nil {
+		*h = make(histogram, len(other))
+	}
+	for bin, count := range other {
+		(*h)[bin] += count
+	}
+}
+
+// quantile estimates the latency below which a fraction q of the counts
+// fall, interpolating linearly within the bin it lands in
+func (h histogram) quantile(q float64) time.Duration {
+	var total int64
+	bins := make([]int, 0, len(h))
+	for bin, count := range h {
+		total += count
+		bins = append(bins, bin)
+	}
+	if total == 0 {
+		return 0
+	}
+	slices.Sort(bins)
+
+	rank := q * float64(total)
+	var seen int64
+	for _, bin := range bins {
+		count := h[bin]
+		if float64(seen+count) >= rank {
+			lower, upper := binBounds(bin)
+			frac := (rank - float64(seen)) / float64(count)
+			return msToDuration(lower + frac*(upper-lower))
+		}
+		seen += count
+	}
+
+	lower, _ := binBounds(bins[len(bins)-1])
+	return msToDuration(lower)
+}
+
+// latencyBin returns the bin a latency is counted in
+func latencyBin(d time.Duration) int {
+	ms := float64(d) / float64(time.Millisecond)
+	if ms < 1 {
+		return 0
+	}
+	return min(int(math.Floor(binsPerDoubling*math.Log2(ms)))+1, maxBin)
+}
+
+// binBounds returns the lower and upper latency of a bin in milliseconds.
+// The last bin is open-ended, so its upper bound is its lower bound.
+func binBounds(bin int) (float64, float64) {
+	if bin == 0 {
+		return 0, 1
+	}
+	lower := math.Exp2(float64(bin-1) / binsPerDoubling)
+	if bin == maxBin {
+		return lower, lower
+	}
+	return lower, math.Exp2(float64(bin) / binsPerDoubling)
+}
+
+// msToDuration converts fractional milliseconds to a duration
+func msToDuration(ms float64) time.Duration {
+	return time.Duration(ms * float64(time.Millisecond))
+}
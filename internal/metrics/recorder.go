@@ -0,0 +1,368 @@
+// Package metrics computes rolling RED (rate, errors, duration) metrics per
+// service and route from request logs.
+package metrics
+
+import (
+	"cmp"
+	"errors"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultResolution is the width of the time buckets windows are built from
+	DefaultResolution = 10 * time.Second
+
+	// DefaultMaxRoutes is the number of distinct routes tracked per service
+	// when Options.MaxRoutes is unset
+	DefaultMaxRoutes = 100
+
+	// OtherRoute is the method and path that requests are counted under once
+	// a service has more than MaxRoutes distinct routes
+	OtherRoute = "(other)"
+)
+
+// DefaultWindows are the windows metrics are reported over when none are
+// configured
+var DefaultWindows = []time.Duration{time.Minute, 5 * time.Minute, 15 * time.Minute}
+
+// Options configures a Recorder
+type Options struct {
+	// Windows are the durations metrics are reported over. Each is rounded
+	// up to a whole number of buckets.
+	Windows []time.Duration
+
+	// Resolution is the bucket width, DefaultResolution if zero
+	Resolution time.Duration
+
+	// MaxRoutes bounds the number of routes tracked per service,
+	// DefaultMaxRoutes if zero
+	MaxRoutes int
+}
+
+// Sample is a single completed request
+type Sample struct {
+	Method   string
+	Path     string
+	Status   int32
+	Duration time.Duration
+	Time     time.Time
+}
+
+// Window holds the metrics for one window
+type Window struct {
+	Duration time.Duration
+	Requests int64
+	Errors   int64 // Requests with a 5xx status
+
+	// Rate is requests per second and ErrorRate the fraction of requests
+	// that were errors
+	Rate      float64
+	ErrorRate float64
+
+	// Latency percentiles, zero if there were no requests
+	P50 time.Duration
+	P95 time.Duration
+	P99 time.Duration
+}
+
+// Route identifies requests by method and path
+type Route struct {
+	Method string
+	Path   string
+}
+
+// RouteMetrics holds the metrics of a route for each window
+type RouteMetrics struct {
+	Route
+	Windows []Window
+}
+
+// ServiceMetrics holds the metrics of a service for each window
+type ServiceMetrics struct {
+	Service string
+	Windows []Window
+	Routes  []RouteMetrics // Only set if requested
+}
+
+// Recorder aggregates samples into per-service and per-route series of
+// fixed width time buckets. Samples older than the largest window are
+// dropped.
+type Recorder struct {
+	windows    []time.Duration
+	resolution time.Duration
+	maxRoutes  int
+	slots      int
+
+	mu       sync.Mutex
+	services map[string]*serviceSeries
+}
+
+// serviceSeries holds the totals and per-route series of a service
+type serviceSeries struct {
+	total  *series
+	routes map[Route]*series
+}
+
+// NewRecorder creates a Recorder
+func NewRecorder(opts Options) (*Recorder, error) {
+	if len(opts.Windows) == 0 {
+		return nil, errors.New("at least one metrics window is required")
+	}
+
+	r := &Recorder{
+		windows:    slices.Clone(opts.Windows),
+		resolution: cmp.Or(opts.Resolution, DefaultResolution),
+		maxRoutes:  cmp.Or(opts.MaxRoutes, DefaultMaxRoutes),
+		services:   make(map[string]*serviceSeries),
+	}
+
+	for _, w := range r.windows {
+		if w <= 0 {
+			return nil, errors.New("metrics windows must be positive")
+		}
+	}
+
+	// Enough slots for the largest window plus the partially filled
+	// current bucket
+	r.slots = int(r.bucketsFor(slices.Max(r.windows))) + 1
+
+	return r, nil
+}
+
+// Windows returns the configured windows
+func (r *Recorder) Windows() []time.Duration {
+	return slices.Clone(r.windows)
+}
+
+// bucketsFor returns the number of buckets covering a window
+func (r *Recorder) bucketsFor(window time.Duration) int64 {
+	return int64((window + r.resolution - 1) / r.resolution)
+}
+
+// Record adds samples for a service. now is used to drop samples that are
+// too old to fall in any window; samples from the future are counted as now.
+func (r *Recorder) Record(service string, samples []Sample, now time.Time) {
+	if len(samples) == 0 {
+		return
+	}
+
+	nowIdx := r.bucketIndex(now)
+	oldest := nowIdx - int64(r.slots) + 1
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	svc, ok := r.services[service]
+	if !ok {
+		svc = &serviceSeries{
+			total:  newSeries(r.slots),
+			routes: make(map[Route]*series),
+		}
+		r.services[service] = svc
+	}
+
+	for _, sample := range samples {
+		idx := min(r.bucketIndex(sample.Time), nowIdx)
+		if idx < oldest {
+			continue
+		}
+
+		route := Route{Method: sample.Method, Path: routePath(sample.Path)}
+		rs, ok := svc.routes[route]
+		if !ok {
+			if len(svc.routes) >= r.maxRoutes {
+				route = Route{Method: OtherRoute, Path: OtherRoute}
+				rs = svc.routes[route]
+			}
+			if rs == nil {
+				rs = newSeries(r.slots)
+				svc.routes[route] = rs
+			}
+		}
+
+		svc.total.add(idx, sample)
+		rs.add(idx, sample)
+	}
+}
+
+// Prune drops series with no samples in the largest window, so services
+// and routes that stopped receiving requests are eventually forgotten
+func (r *Recorder) Prune(now time.Time) {
+	oldest := r.bucketIndex(now) - int64(r.slots) + 1
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for name, svc := range r.services {
+		for route, rs := range svc.routes {
+			if rs.newest < oldest {
+				delete(svc.routes, route)
+			}
+		}
+		if len(svc.routes) == 0 {
+			delete(r.services, name)
+		}
+	}
+}
+
+// Services returns the names of services with recorded samples
+func (r *Recorder) Services() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	names := make([]string, 0, len(r.services))
+	for name := range r.services {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// Service returns the metrics of a service for every window, with
+// per-route metrics if routes is set. ok is false if nothing was recorded
+// for the service.
+func (r *Recorder) Service(service string, routes bool, now time.Time) (ServiceMetrics, bool) {
+	nowIdx := r.bucketIndex(now)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	svc, ok := r.services[service]
+	if !ok {
+		return ServiceMetrics{Service: service, Windows: r.emptyWindows()}, false
+	}
+
+	m := ServiceMetrics{
+		Service: service,
+		Windows: r.collectWindows(svc.total, nowIdx),
+	}
+
+	if routes {
+		for route, rs := range svc.routes {
+			m.Routes = append(m.Routes, RouteMetrics{
+				Route:   route,
+				Windows: r.collectWindows(rs, nowIdx),
+			})
+		}
+		slices.SortFunc(m.Routes, func(a, b RouteMetrics) int {
+			return cmp.Or(cmp.Compare(a.Path, b.Path), cmp.Compare(a.Method, b.Method))
+		})
+	}
+
+	return m, true
+}
+
+// Summary returns the metrics of a service over a single window, which
+// need not be one of the configured windows but is capped to the largest
+func (r *Recorder) Summary(service string, window time.Duration, now time.Time) (Window, bool) {
+	nowIdx := r.bucketIndex(now)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	svc, ok := r.services[service]
+	if !ok {
+		return Window{}, false
+	}
+	return r.collectWindow(svc.total, nowIdx, window), true
+}
+
+// emptyWindows returns zeroed metrics for every window
+func (r *Recorder) emptyWindows() []Window {
+	windows := make([]Window, 0, len(r.windows))
+	for _, w := range r.windows {
+		windows = append(windows, Window{Duration: w})
+	}
+	return windows
+}
+
+// collectWindows computes the metrics of a series for every window.
+// Caller must hold r.mu.
+func (r *Recorder) collectWindows(s *series, nowIdx int64) []Window {
+	windows := make([]Window, 0, len(r.windows))
+	for _, w := range r.windows {
+		windows = append(windows, r.collectWindow(s, nowIdx, w))
+	}
+	return windows
+}
+
+// collectWindow computes the metrics of a series over a window ending in
+// the current bucket. Caller must hold r.mu.
+func (r *Recorder) collectWindow(s *series, nowIdx int64, window time.Duration) Window {
+	n := min(r.bucketsFor(window), int64(r.slots))
+	result := Window{Duration: window}
+
+	var hist histogram
+	for _, b := range s.buckets {
+		if b.index <= nowIdx-n || b.index > nowIdx {
+			continue
+		}
+		result.Requests += b.requests
+		result.Errors += b.errors
+		hist.merge(b.latency)
+	}
+
+	if result.Requests == 0 {
+		return result
+	}
+
+	result.Rate = float64(result.Requests) / window.Seconds()
+	result.ErrorRate = float64(result.Errors) / float64(result.Requests)
+	result.P50 = hist.quantile(0.50)
+	result.P95 = hist.quantile(0.95)
+	result.P99 = hist.quantile(0.99)
+
+	return result
+}
+
+// bucketIndex returns the index of the bucket a time falls in
+func (r *Recorder) bucketIndex(t time.Time) int64 {
+	return t.UnixNano() / int64(r.resolution)
+}
+
+// routePath strips the query string so it doesn't create a route per request
+func routePath(path string) string {
+	path, _, _ = strings.Cut(path, "?")
+	return path
+}
+
+// series is a ring of time buckets
+type series struct {
+	buckets []bucket
+	newest  int64 // Index of the newest bucket written
+}
+
+// bucket holds the samples of one resolution interval
+type bucket struct {
+	index    int64
+	requests int64
+	errors   int64
+	latency  histogram
+}
+
+func newSeries(slots int) *series {
+	return &series{buckets: make([]bucket, slots)}
+}
+
+// add counts a sample in the bucket with the given index, evicting the
+// bucket previously stored in its slot. Samples for a slot that already
+// holds a newer bucket are dropped.
+func (s *series) add(idx int64, sample Sample) {
+	b := &s.buckets[idx%int64(len(s.buckets))]
+	if b.index > idx {
+		return
+	}
+	if b.index < idx {
+		*b = bucket{index: idx}
+	}
+
+	b.requests++
+	if sample.Status >= 500 {
+		b.errors++
+	}
+	b.latency.add(sample.Duration)
+	s.newest = max(s.newest, idx)
+}
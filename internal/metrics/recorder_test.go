@@ -0,0 +1,142 @@
+package metrics
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRecorderValidation(t *testing.T) {
+	_, err := NewRecorder(Options{})
+	require.Error(t, err)
+
+	_, err = NewRecorder(Options{Windows: []time.Duration{time.Minute, 0}})
+	require.Error(t, err)
+}
+
+func TestRecorderWindows(t *testing.T) {
+	r, err := NewRecorder(Options{Windows: []time.Duration{time.Minute, 5 * time.Minute}})
+	require.NoError(t, err)
+
+	now := time.Unix(1_700_000_000, 0)
+
+	// 100 requests in the last minute taking 1-100ms, every 10th failing
+	var recent []Sample
+	for i := range 100 {
+		status := int32(200)
+		if i%10 == 0 {
+			status = 503
+		}
+		recent = append(recent, Sample{
+			Method:   "GET",
+			Path:     "/users?page=2",
+			Status:   status,
+			Duration: time.Duration(i+1) * time.Millisecond,
+			Time:     now.Add(-time.Duration(i) * 500 * time.Millisecond),
+		})
+	}
+	r.Record("api", recent, now)
+
+	// 200 older requests, outside the 1 minute window
+	var older []Sample
+	for range 200 {
+		older = append(older, Sample{
+			Method:   "POST",
+			Path:     "/orders",
+			Status:   404,
+			Duration: 10 * time.Millisecond,
+			Time:     now.Add(-3 * time.Minute),
+		})
+	}
+	r.Record("api", older, now)
+
+	// Too old for any window
+	r.Record("api", []Sample{{Method: "GET", Path: "/", Status: 200, Time: now.Add(-time.Hour)}}, now)
+
+	m, ok := r.Service("api", true, now)
+	require.True(t, ok)
+	require.Len(t, m.Windows, 2)
+
+	minute := m.Windows[0]
+	require.Equal(t, time.Minute, minute.Duration)
+	require.Equal(t, int64(100), minute.Requests)
+	require.Equal(t, int64(10), minute.Errors)
+	require.InDelta(t, 100.0/60, minute.Rate, 0.001)
+	require.InDelta(t, 0.1, minute.ErrorRate, 0.001)
+
+	// Percentiles are within the histogram's bin precision
+	require.InDelta(t, 50, float64(minute.P50)/float64(time.Millisecond), 50*0.2)
+	require.InDelta(t, 95, float64(minute.P95)/float64(time.Millisecond), 95*0.2)
+	require.InDelta(t, 99, float64(minute.P99)/float64(time.Millisecond), 99*0.2)
+
+	five := m.Windows[1]
+	require.Equal(t, int64(300), five.Requests)
+	require.Equal(t, int64(10), five.Errors)
+
+	// Routes ignore the query string and are sorted by path
+	require.Len(t, m.Routes, 2)
+	require.Equal(t, Route{Method: "POST", Path: "/orders"}, m.Routes[0].Route)
+	require.Equal(t, Route{Method: "GET", Path: "/users"}, m.Routes[1].Route)
+	require.Equal(t, int64(0), m.Routes[0].Windows[0].Requests)
+	require.Equal(t, int64(200), m.Routes[0].Windows[1].Requests)
+
+	summary, ok := r.Summary("api", time.Minute, now)
+	require.True(t, ok)
+	require.Equal(t, minute, summary)
+
+	_, ok = r.Summary("orders", time.Minute, now)
+	require.False(t, ok)
+}
+
+func TestRecorderMaxRoutes(t *testing.T) {
+	r, err := NewRecorder(Options{Windows: []time.Duration{time.Minute}, MaxRoutes: 3})
+	require.NoError(t, err)
+
+	now := time.Now()
+	var samples []Sample
+	for i := range 10 {
+		samples = append(samples, Sample{Method: "GET", Path: fmt.Sprintf("/items/%d", i), Status: 200, Time: now})
+	}
+	r.Record("api", samples, now)
+
+	m, ok := r.Service("api", true, now)
+	require.True(t, ok)
+	require.Len(t, m.Routes, 4)
+	require.Equal(t, Route{Method: OtherRoute, Path: OtherRoute}, m.Routes[0].Route)
+	require.Equal(t, int64(7), m.Routes[0].Windows[0].Requests)
+	require.Equal(t, int64(10), m.Windows[0].Requests)
+}
+
+func TestRecorderPrune(t *testing.T) {
+	r, err := NewRecorder(Options{Windows: []time.Duration{time.Minute}})
+	require.NoError(t, err)
+
+	now := time.Now()
+	r.Record("api", []Sample{{Method: "GET", Path: "/", Status: 200, Time: now}}, now)
+	r.Record("orders", []Sample{{Method: "GET", Path: "/", Status: 200, Time: now}}, now)
+	require.Equal(t, []string{"api", "orders"}, r.Services())
+
+	later := now.Add(2 * time.Minute)
+	r.Record("orders", []Sample{{Method: "GET", Path: "/", Status: 200, Time: later}}, later)
+	r.Prune(later)
+	require.Equal(t, []string{"orders"}, r.Services())
+
+	// Buckets that fell out of the window no longer count
+	m, _ := r.Service("orders", false, later)
+	require.Equal(t, int64(1), m.Windows[0].Requests)
+}
+
+func TestHistogramQuantile(t *testing.T) {
+	var h histogram
+	require.Equal(t, time.Duration(0), h.quantile(0.5))
+
+	for range 1000 {
+		h.add(200 * time.Microsecond)
+	}
+	require.Less(t, h.quantile(0.99), time.Millisecond)
+
+	h.add(time.Hour)
+	require.Greater(t, h.quantile(1), 15*time.Minute)
+}
@@ -0,0 +1,215 @@
+package serf
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/hashicorp/memberlist"
+	"github.com/hashicorp/serf/serf"
+)
+
+// KeySize is the size in bytes of keys generated by GenerateKey (AES-256)
+const KeySize = 32
+
+// KeyResponse summarises the result of a keyring operation across the mesh
+type KeyResponse struct {
+	// NumNodes is the number of nodes the request was sent to
+	NumNodes int
+
+	// NumResp is the number of nodes that responded
+	NumResp int
+
+	// NumErr is the number of nodes that reported an error
+	NumErr int
+
+	// Messages maps node names to error messages
+	Messages map[string]string
+
+	// Keys maps base64-encoded keys to the number of nodes with the key installed
+	Keys map[string]int
+
+	// PrimaryKeys maps base64-encoded keys to the number of nodes using the
+	// key as their primary key
+	PrimaryKeys map[string]int
+}
+
+// GenerateKey returns a new random base64-encoded encryption key
+func GenerateKey() (string, error) {
+	key := make([]byte, KeySize)
+	if _, err := rand.Read(key); err != nil {
+		return "", fmt.Errorf("failed to read random data: %w", err)
+	}
+	return base64.StdEncoding.EncodeToString(key), nil
+}
+
+// DecodeKey decodes a base64-encoded encryption key and checks its length
+func DecodeKey(key string) ([]byte, error) {
+	raw, err := base64.StdEncoding.DecodeString(key)
+	if err != nil {
+		return nil, fmt.Errorf("key is not valid base64: %w", err)
+	}
+
+	if err := memberlist.ValidateKey(raw); err != nil {
+		return nil, err
+	}
+
+	return raw, nil
+}
+
+// KeyFingerprint identifies a base64-encoded key without revealing it. It is
+// the first 8 bytes of the SHA-256 of the decoded key, in hex.
+func KeyFingerprint(key string) string {
+	raw, err := base64.StdEncoding.DecodeString(key)
+	if err != nil {
+		raw = []byte(key)
+	}
+	sum := sha256.Sum256(raw)
+	return hex.EncodeToString(sum[:8])
+}
+
+// ListKeys lists the keys installed on members of the mesh
+func (m *Mesh) ListKeys() (*KeyResponse, error) {
+	if m.serf == nil {
+		return nil, fmt.Errorf("mesh is not started")
+	}
+	return keyResponse(m.serf.KeyManager().ListKeys())
+}
+
+// InstallKey installs a new key on all members of the mesh
+func (m *Mesh) InstallKey(key string) (*KeyResponse, error) {
+	if m.serf == nil {
+		return nil, fmt.Errorf("mesh is not started")
+	}
+	return keyResponse(m.serf.KeyManager().InstallKey(key))
+}
+
+// UseKey changes the primary encryption key on all members of the mesh
+func (m *Mesh) UseKey(key string) (*KeyResponse, error) {
+	if m.serf == nil {
+		return nil, fmt.Errorf("mesh is not started")
+	}
+	return keyResponse(m.serf.KeyManager().UseKey(key))
+}
+
+// RemoveKey removes a key from all members of the mesh
+func (m *Mesh) RemoveKey(key string) (*KeyResponse, error) {
+	if m.serf == nil {
+		return nil, fmt.Errorf("mesh is not started")
+	}
+	return keyResponse(m.serf.KeyManager().RemoveKey(key))
+}
+
+// keyResponse converts a Serf key response
+func keyResponse(resp *serf.KeyResponse, err error) (*KeyResponse, error) {
+	if resp == nil {
+		return nil, err
+	}
+
+	return &KeyResponse{
+		NumNodes:    resp.NumNodes,
+		NumResp:     resp.NumResp,
+		NumErr:      resp.NumErr,
+		Messages:    resp.Messages,
+		Keys:        resp.Keys,
+		PrimaryKeys: resp.PrimaryKeys,
+	}, err
+}
+
+// setupKeyring configures gossip encryption. A keyring file takes precedence
+// over the encryption key; if it does not exist yet it is initialised with
+// the key so that keys installed later persist across restarts.
+func (m *Mesh) setupKeyring(conf *serf.Config) error {
+	if m.config.KeyringFile == "" {
+		if len(m.config.EncryptKey) > 0 {
+			conf.MemberlistConfig.SecretKey = m.config.EncryptKey
+		}
+		return nil
+	}
+
+	conf.KeyringFile = m.config.KeyringFile
+
+	_, err := os.Stat(m.config.KeyringFile)
+	if errors.Is(err, os.ErrNotExist) {
+		if len(m.config.EncryptKey) == 0 {
+			// Nothing to encrypt with yet
+			return nil
+		}
+
+		if err := writeKeyringFile(m.config.KeyringFile, [][]byte{m.config.EncryptKey}); err != nil {
+			return err
+		}
+	} else if err != nil {
+		return fmt.Errorf("failed to read keyring file: %w", err)
+	} else if len(m.config.EncryptKey) > 0 {
+		log.Printf("Keyring file %s exists, ignoring encrypt key", m.config.KeyringFile)
+	}
+
+	keys, err := LoadKeyringFile(m.config.KeyringFile)
+	if err != nil {
+		return err
+	}
+
+	keyring, err := memberlist.NewKeyring(keys, keys[0])
+	if err != nil {
+		return fmt.Errorf("failed to create keyring: %w", err)
+	}
+
+	conf.MemberlistConfig.Keyring = keyring
+	return nil
+}
+
+// LoadKeyringFile reads a JSON array of base64-encoded keys, primary first
+func LoadKeyringFile(path string) ([][]byte, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read keyring file: %w", err)
+	}
+
+	var encoded []string
+	if err := json.Unmarshal(data, &encoded); err != nil {
+		return nil, fmt.Errorf("failed to parse keyring file: %w", err)
+	}
+
+	if len(encoded) == 0 {
+		return nil, fmt.Errorf("keyring file %s contains no keys", path)
+	}
+
+	keys := make([][]byte, 0, len(encoded))
+	for _, k := range encoded {
+		key, err := DecodeKey(k)
+		if err != nil {
+			return nil, fmt.Errorf("invalid key in keyring file: %w", err)
+		}
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
+
+// writeKeyringFile writes keys in the format read by LoadKeyringFile, which
+// is also the format Serf uses when persisting keyring changes
+func writeKeyringFile(path string, keys [][]byte) error {
+	encoded := make([]string, len(keys))
+	for i, key := range keys {
+		encoded[i] = base64.StdEncoding.EncodeToString(key)
+	}
+
+	data, err := json.MarshalIndent(encoded, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode keys: %w", err)
+	}
+
+	// Key material is sensitive, keep it private to the owner
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		return fmt.Errorf("failed to write keyring file: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,144 @@
+package serf
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateKey(t *testing.T) {
+	key, err := GenerateKey()
+	require.NoError(t, err)
+
+	raw, err := DecodeKey(key)
+	require.NoError(t, err)
+	require.Len(t, raw, KeySize)
+}
+
+func TestDecodeKeyInvalid(t *testing.T) {
+	_, err := DecodeKey("not base64!")
+	require.Error(t, err)
+
+	// Valid base64, wrong length
+	_, err = DecodeKey("c2hvcnQ=")
+	require.Error(t, err)
+}
+
+func TestKeyFingerprint(t *testing.T) {
+	key, err := GenerateKey()
+	require.NoError(t, err)
+	other, err := GenerateKey()
+	require.NoError(t, err)
+
+	fingerprint := KeyFingerprint(key)
+	require.Len(t, fingerprint, 16)
+	require.Equal(t, fingerprint, KeyFingerprint(key))
+	require.NotEqual(t, fingerprint, KeyFingerprint(other))
+}
+
+func TestMeshEncryptedJoin(t *testing.T) {
+	key, err := GenerateKey()
+	require.NoError(t, err)
+	raw, err := DecodeKey(key)
+	require.NoError(t, err)
+
+	mesh1, err := NewMesh(MeshConfig{
+		NodeName:   "node1",
+		BindAddr:   "127.0.0.1",
+		EncryptKey: raw,
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	require.NoError(t, mesh1.Start(ctx))
+	defer mesh1.Stop()
+
+	port := mesh1.Members()[0].Port
+
+	mesh2, err := NewMesh(MeshConfig{
+		NodeName:   "node2",
+		BindAddr:   "127.0.0.1",
+		EncryptKey: raw,
+		JoinAddrs:  []string{fmt.Sprintf("127.0.0.1:%d", port)},
+	})
+	require.NoError(t, err)
+	require.NoError(t, mesh2.Start(ctx))
+	defer mesh2.Stop()
+
+	require.Eventually(t, func() bool {
+		return len(mesh1.Members()) == 2
+	}, 2*time.Second, 50*time.Millisecond)
+
+	resp, err := mesh1.ListKeys()
+	require.NoError(t, err)
+	require.Equal(t, 2, resp.NumNodes)
+	require.Equal(t, 2, resp.Keys[key])
+	require.Equal(t, 2, resp.PrimaryKeys[key])
+}
+
+func TestMeshKeyringFilePersists(t *testing.T) {
+	key, err := GenerateKey()
+	require.NoError(t, err)
+	raw, err := DecodeKey(key)
+	require.NoError(t, err)
+
+	keyringFile := filepath.Join(t.TempDir(), "keyring")
+
+	mesh, err := NewMesh(MeshConfig{
+		NodeName:    "node1",
+		BindAddr:    "127.0.0.1",
+		EncryptKey:  raw,
+		KeyringFile: keyringFile,
+	})
+	require.NoError(t, err)
+	require.NoError(t, mesh.Start(context.Background()))
+	defer mesh.Stop()
+
+	// The keyring file is initialised from the encryption key
+	keys, err := LoadKeyringFile(keyringFile)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{raw}, keys)
+
+	// Installed keys are persisted
+	newKey, err := GenerateKey()
+	require.NoError(t, err)
+
+	resp, err := mesh.InstallKey(newKey)
+	require.NoError(t, err)
+	require.Equal(t, 0, resp.NumErr)
+
+	keys, err = LoadKeyringFile(keyringFile)
+	require.NoError(t, err)
+	require.Len(t, keys, 2)
+
+	// Switching the primary key and removing the old one
+	_, err = mesh.UseKey(newKey)
+	require.NoError(t, err)
+	_, err = mesh.RemoveKey(key)
+	require.NoError(t, err)
+
+	keys, err = LoadKeyringFile(keyringFile)
+	require.NoError(t, err)
+	require.Len(t, keys, 1)
+
+	newRaw, err := DecodeKey(newKey)
+	require.NoError(t, err)
+	require.Equal(t, newRaw, keys[0])
+}
+
+func TestMeshKeyringWithoutEncryption(t *testing.T) {
+	mesh, err := NewMesh(MeshConfig{
+		NodeName: "node1",
+		BindAddr: "127.0.0.1",
+	})
+	require.NoError(t, err)
+	require.NoError(t, mesh.Start(context.Background()))
+	defer mesh.Stop()
+
+	_, err = mesh.ListKeys()
+	require.Error(t, err)
+}
@@ -4,8 +4,11 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"maps"
 	"sync"
+	"time"
 
+	"github.com/hashicorp/serf/coordinate"
 	"github.com/hashicorp/serf/serf"
 	"github.com/jumppad-labs/lattice/internal/topology"
 )
@@ -24,8 +27,41 @@ type MeshConfig struct {
 	// Tags are metadata tags for this node
 	Tags map[string]string
 
+	// AdvertiseAddr is the address advertised to other nodes. If empty, the
+	// bind address is advertised.
+	AdvertiseAddr string
+
+	// AdvertisePort is the port advertised to other nodes along with
+	// AdvertiseAddr. If zero, the bind port is advertised. It is ignored
+	// when AdvertiseAddr is empty.
+	AdvertisePort int
+
 	// JoinAddrs are addresses of existing nodes to join
 	JoinAddrs []string
+
+	// RetryJoinInterval is the time to wait between join attempts. If zero,
+	// joining is attempted once and failure is returned from Start.
+	RetryJoinInterval time.Duration
+
+	// RetryJoinMaxAttempts is the maximum number of join attempts when
+	// RetryJoinInterval is set. Zero means retry until the mesh is stopped.
+	RetryJoinMaxAttempts int
+
+	// EncryptKey is the gossip encryption key (16, 24 or 32 bytes). If
+	// empty and no keyring file exists, gossip is not encrypted.
+	EncryptKey []byte
+
+	// KeyringFile is where the keyring is persisted. Keys installed with
+	// InstallKey are written here and loaded on the next start.
+	KeyringFile string
+
+	// TopologyTTL is how long a node's topology event stays valid. Nodes
+	// that don't publish again within the TTL are removed from the graph.
+	// Zero disables expiry.
+	TopologyTTL time.Duration
+
+	// Routing selects how Routes chooses paths (default RoutingHops)
+	Routing RoutingMode
 }
 
 // Member represents a member in the mesh
@@ -44,6 +80,10 @@ type Member struct {
 
 	// Status is the status of the member (alive, leaving, left, failed)
 	Status string
+
+	// Coordinate is the member's Vivaldi network coordinate, or nil if it
+	// is not known yet. Only set by Members.
+	Coordinate *coordinate.Coordinate
 }
 
 // Mesh manages a Serf gossip mesh for service discovery
@@ -53,9 +93,11 @@ type Mesh struct {
 	config  MeshConfig
 
 	// Event callbacks
-	joinCallbacks  []func(*Member)
-	leaveCallbacks []func(*Member)
-	mu             sync.RWMutex
+	joinCallbacks     []func(*Member)
+	leaveCallbacks    []func(*Member)
+	updateCallbacks   []func(*Member)
+	topologyCallbacks []func()
+	mu                sync.RWMutex
 
 	// Topology graph
 	graph *topology.Graph
@@ -63,6 +105,11 @@ type Mesh struct {
 	// Event channel for processing events
 	eventCh chan serf.Event
 	stopCh  chan struct{}
+
+	// Events processed by Serf event type, for telemetry
+	eventsMu sync.Mutex
+	events   map[string]uint64
+
 	stopped bool
 	stopMu  sync.Mutex
 }
@@ -77,6 +124,10 @@ func NewMesh(config MeshConfig) (*Mesh, error) {
 		config.BindAddr = "0.0.0.0"
 	}
 
+	if config.Routing == "" {
+		config.Routing = RoutingHops
+	}
+
 	// Note: BindPort of 0 means let the OS choose a random port
 	// Default to 7946 only if not explicitly set during Start
 
@@ -85,6 +136,7 @@ func NewMesh(config MeshConfig) (*Mesh, error) {
 		eventCh: make(chan serf.Event, 256),
 		stopCh:  make(chan struct{}),
 		graph:   topology.NewGraph(),
+		events:  make(map[string]uint64),
 	}
 
 	return m, nil
@@ -97,9 +149,23 @@ func (m *Mesh) Start(ctx context.Context) error {
 	conf.NodeName = m.config.NodeName
 	conf.MemberlistConfig.BindAddr = m.config.BindAddr
 	conf.MemberlistConfig.BindPort = m.config.BindPort
+	if m.config.AdvertiseAddr != "" {
+		conf.MemberlistConfig.AdvertiseAddr = m.config.AdvertiseAddr
+		conf.MemberlistConfig.AdvertisePort = m.config.AdvertisePort
+		if conf.MemberlistConfig.AdvertisePort == 0 {
+			conf.MemberlistConfig.AdvertisePort = m.config.BindPort
+		}
+	}
 	conf.Tags = m.config.Tags
 	conf.EventCh = m.eventCh
 
+	// Network coordinates are used to estimate RTT for latency routing
+	conf.DisableCoordinates = false
+
+	if err := m.setupKeyring(conf); err != nil {
+		return err
+	}
+
 	// Create Serf instance
 	s, err := serf.Create(conf)
 	if err != nil {
@@ -111,17 +177,52 @@ func (m *Mesh) Start(ctx context.Context) error {
 	// Start event processing
 	go m.processEvents(ctx)
 
+	// Expire stale topology
+	if m.config.TopologyTTL > 0 {
+		go m.reapTopology(ctx)
+	}
+
 	// Join existing cluster if addresses provided
 	if len(m.config.JoinAddrs) > 0 {
-		_, err := m.serf.Join(m.config.JoinAddrs, false)
-		if err != nil {
-			return fmt.Errorf("failed to join cluster: %w", err)
+		if m.config.RetryJoinInterval > 0 {
+			go m.retryJoin()
+		} else {
+			_, err := m.serf.Join(m.config.JoinAddrs, false)
+			if err != nil {
+				return fmt.Errorf("failed to join cluster: %w", err)
+			}
 		}
 	}
 
 	return nil
 }
 
+// retryJoin attempts to join the cluster until it succeeds, the maximum
+// number of attempts is reached, or the mesh is stopped
+func (m *Mesh) retryJoin() {
+	for attempt := 1; ; attempt++ {
+		n, err := m.serf.Join(m.config.JoinAddrs, false)
+		if n > 0 {
+			log.Printf("Joined cluster via %d node(s)", n)
+			return
+		}
+
+		if m.config.RetryJoinMaxAttempts > 0 && attempt >= m.config.RetryJoinMaxAttempts {
+			log.Printf("Failed to join cluster after %d attempts: %v", attempt, err)
+			return
+		}
+
+		log.Printf("Failed to join cluster (attempt %d), retrying in %s: %v",
+			attempt, m.config.RetryJoinInterval, err)
+
+		select {
+		case <-m.stopCh:
+			return
+		case <-time.After(m.config.RetryJoinInterval):
+		}
+	}
+}
+
 // Stop shuts down the mesh
 func (m *Mesh) Stop() error {
 	m.stopMu.Lock()
@@ -152,6 +253,11 @@ func (m *Mesh) Stop() error {
 	return nil
 }
 
+// NodeName returns the name of the local node in the mesh
+func (m *Mesh) NodeName() string {
+	return m.config.NodeName
+}
+
 // Members returns a list of all members in the mesh
 func (m *Mesh) Members() []*Member {
 	if m.serf == nil {
@@ -169,6 +275,9 @@ func (m *Mesh) Members() []*Member {
 			Tags:   sm.Tags,
 			Status: sm.Status.String(),
 		}
+		if coord, ok := m.serf.GetCachedCoordinate(sm.Name); ok {
+			member.Coordinate = coord
+		}
 		members = append(members, member)
 	}
 
@@ -189,6 +298,21 @@ func (m *Mesh) OnLeave(fn func(*Member)) {
 	m.leaveCallbacks = append(m.leaveCallbacks, fn)
 }
 
+// OnUpdate registers a callback to be called when a member's tags change
+func (m *Mesh) OnUpdate(fn func(*Member)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.updateCallbacks = append(m.updateCallbacks, fn)
+}
+
+// OnTopologyChange registers a callback to be called when a topology user
+// event updates the graph
+func (m *Mesh) OnTopologyChange(fn func()) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.topologyCallbacks = append(m.topologyCallbacks, fn)
+}
+
 // processEvents processes Serf events from the event channel
 func (m *Mesh) processEvents(ctx context.Context) {
 	for {
@@ -199,6 +323,53 @@ func (m *Mesh) processEvents(ctx context.Context) {
 			return
 		case e := <-m.eventCh:
 			m.handleEvent(e)
+
+			m.eventsMu.Lock()
+			m.events[e.EventType().String()]++
+			m.eventsMu.Unlock()
+		}
+	}
+}
+
+// EventStats describes Serf event processing
+type EventStats struct {
+	// Processed counts the events handled by Serf event type
+	Processed map[string]uint64
+
+	// Queued is the number of events waiting to be handled, out of Capacity
+	Queued   int
+	Capacity int
+}
+
+// EventStats returns event processing statistics
+func (m *Mesh) EventStats() EventStats {
+	m.eventsMu.Lock()
+	defer m.eventsMu.Unlock()
+
+	return EventStats{
+		Processed: maps.Clone(m.events),
+		Queued:    len(m.eventCh),
+		Capacity:  cap(m.eventCh),
+	}
+}
+
+// reapTopology periodically removes graph nodes whose topology events are
+// older than the TTL
+func (m *Mesh) reapTopology(ctx context.Context) {
+	interval := max(m.config.TopologyTTL/2, time.Second)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-m.stopCh:
+			return
+		case <-ticker.C:
+			if expired := m.graph.Expire(m.config.TopologyTTL); len(expired) > 0 {
+				m.notifyTopologyChange()
+			}
 		}
 	}
 }
@@ -254,6 +425,11 @@ func (m *Mesh) handleMemberLeave(e serf.MemberEvent) {
 
 		m.members.Delete(sm.Name)
 
+		// Stop routing through the departed node
+		if m.graph.RemoveNode(sm.Name) {
+			log.Printf("Removed %s from topology graph", sm.Name)
+		}
+
 		// Trigger callbacks
 		m.mu.RLock()
 		callbacks := m.leaveCallbacks
@@ -277,15 +453,41 @@ func (m *Mesh) handleMemberUpdate(e serf.MemberEvent) {
 		}
 
 		m.members.Store(sm.Name, member)
+
+		// Trigger callbacks
+		m.mu.RLock()
+		callbacks := m.updateCallbacks
+		m.mu.RUnlock()
+
+		for _, fn := range callbacks {
+			go fn(member)
+		}
 	}
 }
 
 // handleUserEvent handles user events (topology updates)
 func (m *Mesh) handleUserEvent(e serf.UserEvent) {
 	// Check if this is a topology event
-	if e.Name == "topology" {
-		m.graph.Update(e.Payload)
-		log.Printf("Topology updated from node")
+	if e.Name != "topology" {
+		return
+	}
+
+	if err := m.graph.Update(e.Payload); err != nil {
+		log.Printf("Failed to parse topology event: %v", err)
+		return
+	}
+
+	m.notifyTopologyChange()
+}
+
+// notifyTopologyChange triggers topology change callbacks
+func (m *Mesh) notifyTopologyChange() {
+	m.mu.RLock()
+	callbacks := m.topologyCallbacks
+	m.mu.RUnlock()
+
+	for _, fn := range callbacks {
+		go fn()
 	}
 }
 
@@ -378,3 +378,245 @@ func TestFilterMembers(t *testing.T) {
 	require.Len(t, aliveHttpServices, 1)
 	require.Equal(t, "service1", aliveHttpServices[0].Name)
 }
+
+func TestMeshRetryJoin(t *testing.T) {
+	// Create first mesh
+	config1 := MeshConfig{
+		NodeName: "node1",
+		BindAddr: "127.0.0.1",
+		BindPort: 0,
+	}
+
+	mesh1, err := NewMesh(config1)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	err = mesh1.Start(ctx)
+	require.NoError(t, err)
+	defer mesh1.Stop()
+
+	members := mesh1.Members()
+	require.Len(t, members, 1)
+	actualPort := members[0].Port
+
+	// Join in the background with retries
+	config2 := MeshConfig{
+		NodeName:             "node2",
+		BindAddr:             "127.0.0.1",
+		BindPort:             0,
+		JoinAddrs:            []string{fmt.Sprintf("127.0.0.1:%d", actualPort)},
+		RetryJoinInterval:    50 * time.Millisecond,
+		RetryJoinMaxAttempts: 5,
+	}
+
+	mesh2, err := NewMesh(config2)
+	require.NoError(t, err)
+
+	err = mesh2.Start(ctx)
+	require.NoError(t, err)
+	defer mesh2.Stop()
+
+	require.Eventually(t, func() bool {
+		return len(mesh1.Members()) == 2
+	}, 2*time.Second, 50*time.Millisecond)
+}
+
+func TestMeshNodeName(t *testing.T) {
+	mesh, err := NewMesh(MeshConfig{NodeName: "observer-1"})
+	require.NoError(t, err)
+	require.Equal(t, "observer-1", mesh.NodeName())
+}
+
+func TestMeshUpdateAndTopologyEvents(t *testing.T) {
+	mesh1, err := NewMesh(MeshConfig{
+		NodeName: "node1",
+		BindAddr: "127.0.0.1",
+		BindPort: 0,
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	require.NoError(t, mesh1.Start(ctx))
+	defer mesh1.Stop()
+
+	updated := make(chan *Member, 1)
+	mesh1.OnUpdate(func(m *Member) {
+		if m.Name == "node2" {
+			select {
+			case updated <- m:
+			default:
+			}
+		}
+	})
+
+	topologyChanged := make(chan struct{}, 1)
+	mesh1.OnTopologyChange(func() {
+		select {
+		case topologyChanged <- struct{}{}:
+		default:
+		}
+	})
+
+	mesh2, err := NewMesh(MeshConfig{
+		NodeName:  "node2",
+		BindAddr:  "127.0.0.1",
+		BindPort:  0,
+		JoinAddrs: []string{fmt.Sprintf("127.0.0.1:%d", mesh1.Members()[0].Port)},
+	})
+	require.NoError(t, err)
+	require.NoError(t, mesh2.Start(ctx))
+	defer mesh2.Stop()
+
+	// Tag changes trigger update callbacks
+	require.NoError(t, mesh2.serf.SetTags(map[string]string{"upstreams": "users"}))
+
+	select {
+	case m := <-updated:
+		require.Equal(t, "users", m.Tags["upstreams"])
+	case <-time.After(2 * time.Second):
+		t.Fatal("timeout waiting for update event")
+	}
+
+	// Topology user events update the graph and trigger callbacks
+	require.NoError(t, mesh2.serf.UserEvent("topology", []byte(`{"n":"node2","nb":["node1"]}`), false))
+
+	select {
+	case <-topologyChanged:
+	case <-time.After(2 * time.Second):
+		t.Fatal("timeout waiting for topology event")
+	}
+
+	require.Equal(t, []string{"node1"}, mesh1.Graph().GetNeighbors("node2"))
+}
+
+func TestMeshLeaveRemovesGraphNode(t *testing.T) {
+	mesh1, err := NewMesh(MeshConfig{
+		NodeName: "node1",
+		BindAddr: "127.0.0.1",
+		BindPort: 0,
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	require.NoError(t, mesh1.Start(ctx))
+	defer mesh1.Stop()
+
+	require.NoError(t, mesh1.Graph().Update([]byte(`{"n":"node2","nb":["node1"]}`)))
+
+	mesh2, err := NewMesh(MeshConfig{
+		NodeName:  "node2",
+		BindAddr:  "127.0.0.1",
+		BindPort:  0,
+		JoinAddrs: []string{fmt.Sprintf("127.0.0.1:%d", mesh1.Members()[0].Port)},
+	})
+	require.NoError(t, err)
+	require.NoError(t, mesh2.Start(ctx))
+
+	left := make(chan struct{})
+	var leftOnce sync.Once
+	mesh1.OnLeave(func(m *Member) {
+		if m.Name == "node2" {
+			leftOnce.Do(func() { close(left) })
+		}
+	})
+
+	require.NoError(t, mesh2.Stop())
+
+	select {
+	case <-left:
+	case <-time.After(2 * time.Second):
+		t.Fatal("timeout waiting for leave event")
+	}
+
+	require.Nil(t, mesh1.Graph().FindPath("node1", "node2"))
+	require.Empty(t, mesh1.Graph().GetNeighbors("node1"))
+}
+
+func TestMeshTopologyTTL(t *testing.T) {
+	mesh, err := NewMesh(MeshConfig{
+		NodeName:    "node1",
+		BindAddr:    "127.0.0.1",
+		BindPort:    0,
+		TopologyTTL: time.Second,
+	})
+	require.NoError(t, err)
+
+	changed := make(chan struct{}, 1)
+	mesh.OnTopologyChange(func() {
+		select {
+		case changed <- struct{}{}:
+		default:
+		}
+	})
+
+	require.NoError(t, mesh.Start(context.Background()))
+	defer mesh.Stop()
+
+	require.NoError(t, mesh.Graph().Update([]byte(`{"n":"node2","nb":["node1"]}`)))
+
+	select {
+	case <-changed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout waiting for topology expiry")
+	}
+
+	require.Empty(t, mesh.Graph().GetNeighbors("node2"))
+}
+
+func TestParseRoutingMode(t *testing.T) {
+	mode, err := ParseRoutingMode("")
+	require.NoError(t, err)
+	require.Equal(t, RoutingHops, mode)
+
+	mode, err = ParseRoutingMode("latency")
+	require.NoError(t, err)
+	require.Equal(t, RoutingLatency, mode)
+
+	_, err = ParseRoutingMode("fastest")
+	require.Error(t, err)
+}
+
+func TestMeshCoordinatesAndRoute(t *testing.T) {
+	mesh1, err := NewMesh(MeshConfig{
+		NodeName: "node1",
+		BindAddr: "127.0.0.1",
+		BindPort: 0,
+		Routing:  RoutingLatency,
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	require.NoError(t, mesh1.Start(ctx))
+	defer mesh1.Stop()
+
+	mesh2, err := NewMesh(MeshConfig{
+		NodeName:  "node2",
+		BindAddr:  "127.0.0.1",
+		BindPort:  0,
+		JoinAddrs: []string{fmt.Sprintf("127.0.0.1:%d", mesh1.Members()[0].Port)},
+	})
+	require.NoError(t, err)
+	require.NoError(t, mesh2.Start(ctx))
+	defer mesh2.Stop()
+
+	// Coordinates are learned from probes, which take a few rounds
+	require.Eventually(t, func() bool {
+		_, ok := mesh1.EstimateRTT("node1", "node2")
+		return ok
+	}, 10*time.Second, 100*time.Millisecond)
+
+	var found bool
+	for _, member := range mesh1.Members() {
+		if member.Name == "node2" {
+			found = member.Coordinate != nil
+		}
+	}
+	require.True(t, found)
+
+	_, ok := mesh1.EstimateRTT("node1", "unknown")
+	require.False(t, ok)
+
+	require.NoError(t, mesh1.Graph().Update([]byte(`{"n":"node2","nb":["node1"]}`)))
+	require.Equal(t, [][]string{{"node1", "node2"}}, mesh1.Routes("node2", 3))
+	require.Empty(t, mesh1.Routes("unknown", 3))
+}
@@ -0,0 +1,76 @@
+package serf
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jumppad-labs/lattice/internal/topology"
+)
+
+// RoutingMode selects how paths through the mesh are chosen
+type RoutingMode string
+
+const (
+	// RoutingHops routes over the path with the fewest hops
+	RoutingHops RoutingMode = "hops"
+
+	// RoutingLatency routes over the path with the lowest total RTT,
+	// estimated from Serf network coordinates
+	RoutingLatency RoutingMode = "latency"
+)
+
+// unknownRTT is the edge cost used when either node has no coordinate yet,
+// high enough that measured paths are preferred
+const unknownRTT = 100 * time.Millisecond
+
+// ParseRoutingMode parses a routing mode name. An empty name selects
+// RoutingHops.
+func ParseRoutingMode(name string) (RoutingMode, error) {
+	switch RoutingMode(name) {
+	case "", RoutingHops:
+		return RoutingHops, nil
+	case RoutingLatency:
+		return RoutingLatency, nil
+	default:
+		return "", fmt.Errorf("unknown routing mode %q (expected %q or %q)", name, RoutingHops, RoutingLatency)
+	}
+}
+
+// EstimateRTT estimates the round-trip time between two nodes from their
+// network coordinates. It returns false if either coordinate is unknown.
+func (m *Mesh) EstimateRTT(from, to string) (time.Duration, bool) {
+	if m.serf == nil {
+		return 0, false
+	}
+
+	a, ok := m.serf.GetCachedCoordinate(from)
+	if !ok {
+		return 0, false
+	}
+
+	b, ok := m.serf.GetCachedCoordinate(to)
+	if !ok || !a.IsCompatibleWith(b) {
+		return 0, false
+	}
+
+	return a.DistanceTo(b), true
+}
+
+// Routes returns up to k disjoint paths from the local node to the target
+// node, best first according to the configured routing mode
+func (m *Mesh) Routes(to string, k int) [][]string {
+	var weight topology.WeightFunc
+	if m.config.Routing == RoutingLatency {
+		weight = m.rttWeight
+	}
+	return m.graph.FindDisjointPaths(m.config.NodeName, to, k, weight)
+}
+
+// rttWeight is the edge weight for latency routing, in milliseconds
+func (m *Mesh) rttWeight(from, to string) float64 {
+	rtt, ok := m.EstimateRTT(from, to)
+	if !ok {
+		rtt = unknownRTT
+	}
+	return float64(rtt) / float64(time.Millisecond)
+}
@@ -0,0 +1,52 @@
+package telemetry
+
+import (
+	"io"
+	"math"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler(t *testing.T) {
+	requests := NewCounterVec("test_requests_total", "Requests handled.", "code")
+	requests.Inc("ok")
+	requests.Add(2, "not_found")
+	requests.Inc("ok")
+
+	duration := NewHistogramVec("test_duration_seconds", "Request duration.", []float64{0.1, 1}, "target")
+	duration.Observe(0.05, "api")
+	duration.Observe(0.1, "api")
+	duration.Observe(5, "api")
+
+	gauge := CollectorFunc(func(w *Writer) {
+		w.Family("test_queue_depth", TypeGauge, "Queued items.")
+		w.Sample("test_queue_depth", 3)
+		w.Sample("test_queue_depth", math.Inf(1), "queue", "with \"quotes\"\n")
+	})
+
+	rec := httptest.NewRecorder()
+	Handler(requests, duration, gauge).ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+
+	require.Equal(t, contentType, rec.Header().Get("Content-Type"))
+
+	body, err := io.ReadAll(rec.Body)
+	require.NoError(t, err)
+	require.Equal(t, `# HELP test_requests_total Requests handled.
+# TYPE test_requests_total counter
+test_requests_total{code="not_found"} 2
+test_requests_total{code="ok"} 2
+# HELP test_duration_seconds Request duration.
+# TYPE test_duration_seconds histogram
+test_duration_seconds_bucket{target="api",le="0.1"} 2
+test_duration_seconds_bucket{target="api",le="1"} 2
+test_duration_seconds_bucket{target="api",le="+Inf"} 3
+test_duration_seconds_sum{target="api"} 5.15
+test_duration_seconds_count{target="api"} 3
+# HELP test_queue_depth Queued items.
+# TYPE test_queue_depth gauge
+test_queue_depth 3
+test_queue_depth{queue="with \"quotes\"\n"} +Inf
+`, string(body))
+}
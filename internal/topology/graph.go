@@ -1,9 +1,12 @@
 package topology
 
 import (
+	"container/heap"
 	"encoding/json"
 	"log"
+	"slices"
 	"sync"
+	"time"
 )
 
 // TopologyEvent is received from Polymorph nodes via Serf user events
@@ -14,51 +17,152 @@ type TopologyEvent struct {
 
 // Graph represents the mesh connectivity graph
 type Graph struct {
-	mu    sync.RWMutex
-	edges map[string][]string // node -> neighbors
+	mu       sync.RWMutex
+	edges    map[string][]string  // node -> neighbors, in either direction
+	reported map[string][]string  // node -> neighbors in its last topology event
+	reverse  map[string][]string  // node -> nodes that reported it as a neighbor
+	lastSeen map[string]time.Time // node -> time of its last topology event
 }
 
 // NewGraph creates a new empty graph
 func NewGraph() *Graph {
 	return &Graph{
-		edges: make(map[string][]string),
+		edges:    make(map[string][]string),
+		reported: make(map[string][]string),
+		reverse:  make(map[string][]string),
+		lastSeen: make(map[string]time.Time),
 	}
 }
 
-// Update updates the graph with a topology event
-func (g *Graph) Update(eventData []byte) {
+// Update updates the graph with a topology event. The event replaces the
+// neighbors the node reported before. Edges are bidirectional: if node A can
+// see node B, then B can likely reach A too, so B keeps its edge to A until A
+// stops reporting it, even if B never publishes events of its own.
+func (g *Graph) Update(eventData []byte) error {
 	var event TopologyEvent
 	if err := json.Unmarshal(eventData, &event); err != nil {
-		log.Printf("Failed to parse topology event: %v", err)
-		return
+		return err
 	}
 
 	g.mu.Lock()
 	defer g.mu.Unlock()
 
-	// Store direct edges: event.Node -> neighbors
-	g.edges[event.Node] = event.Neighbors
-
-	// Also add reverse edges to make graph bidirectional
-	// If Node A can see Node B, then B can likely reach A too
-	for _, neighbor := range event.Neighbors {
-		// Get existing neighbors for this neighbor node
-		existing := g.edges[neighbor]
-
-		// Add event.Node if not already in the list
-		found := false
-		for _, n := range existing {
-			if n == event.Node {
-				found = true
-				break
-			}
+	previous := g.reported[event.Node]
+	neighbors := slices.Clone(event.Neighbors)
+	g.reported[event.Node] = neighbors
+	g.lastSeen[event.Node] = time.Now()
+
+	for _, neighbor := range previous {
+		if !slices.Contains(neighbors, neighbor) {
+			g.reverse[neighbor] = without(g.reverse[neighbor], event.Node)
 		}
-		if !found {
-			g.edges[neighbor] = append(existing, event.Node)
+	}
+	for _, neighbor := range neighbors {
+		if !slices.Contains(g.reverse[neighbor], event.Node) {
+			g.reverse[neighbor] = append(g.reverse[neighbor], event.Node)
 		}
 	}
 
+	g.refreshLocked(event.Node)
+	for _, node := range slices.Concat(previous, neighbors) {
+		g.refreshLocked(node)
+	}
+
 	log.Printf("Topology updated: %s -> %v", event.Node, event.Neighbors)
+	return nil
+}
+
+// refreshLocked recomputes a node's edges from the neighbors it reported
+// and the nodes that reported it. g.mu must be held for writing.
+func (g *Graph) refreshLocked(node string) {
+	reported, ok := g.reported[node]
+
+	neighbors := slices.Clone(reported)
+	for _, n := range g.reverse[node] {
+		if !slices.Contains(neighbors, n) {
+			neighbors = append(neighbors, n)
+		}
+	}
+
+	if !ok && len(neighbors) == 0 {
+		delete(g.edges, node)
+		delete(g.reverse, node)
+		return
+	}
+	g.edges[node] = neighbors
+}
+
+// without returns a copy of list without value
+func without(list []string, value string) []string {
+	return slices.DeleteFunc(slices.Clone(list), func(s string) bool {
+		return s == value
+	})
+}
+
+// RemoveNode removes a node and all edges to and from it. It returns false
+// if the node was not in the graph.
+func (g *Graph) RemoveNode(node string) bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return g.removeNodeLocked(node)
+}
+
+// Expire removes nodes that have not sent a topology event within ttl and
+// returns their names. Nodes only known as someone's neighbor are not
+// expired themselves, but lose their edges to expired nodes.
+func (g *Graph) Expire(ttl time.Duration) []string {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	cutoff := time.Now().Add(-ttl)
+
+	var expired []string
+	for node, seen := range g.lastSeen {
+		if seen.Before(cutoff) {
+			expired = append(expired, node)
+		}
+	}
+
+	for _, node := range expired {
+		g.removeNodeLocked(node)
+		log.Printf("Topology expired: %s (not seen for %s)", node, ttl)
+	}
+
+	return expired
+}
+
+// removeNodeLocked removes a node and its edges. g.mu must be held.
+func (g *Graph) removeNodeLocked(node string) bool {
+	neighbors, hasEdges := g.edges[node]
+	_, hasSeen := g.lastSeen[node]
+
+	for _, n := range g.reported[node] {
+		g.reverse[n] = without(g.reverse[n], node)
+	}
+	for _, n := range g.reverse[node] {
+		g.reported[n] = without(g.reported[n], node)
+	}
+
+	delete(g.edges, node)
+	delete(g.reported, node)
+	delete(g.reverse, node)
+	delete(g.lastSeen, node)
+
+	for _, n := range neighbors {
+		g.refreshLocked(n)
+	}
+
+	return hasEdges || hasSeen
+}
+
+// LastSeen returns when a node last sent a topology event
+func (g *Graph) LastSeen(node string) (time.Time, bool) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	seen, ok := g.lastSeen[node]
+	return seen, ok
 }
 
 // FindPath finds the shortest path from source to target using BFS
@@ -100,6 +204,168 @@ func (g *Graph) FindPath(from, to string) []string {
 	return nil
 }
 
+// WeightFunc returns the cost of the edge between two adjacent nodes
+type WeightFunc func(from, to string) float64
+
+// FindWeightedPath finds the lowest-cost path from source to target using
+// Dijkstra's algorithm. Weights must not be negative.
+func (g *Graph) FindWeightedPath(from, to string, weight WeightFunc) []string {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	return g.shortestPathLocked(from, to, weight, nil, nil)
+}
+
+// FindDisjointPaths finds up to k paths from source to target that share no
+// intermediate nodes and no links, cheapest first. Paths are found greedily:
+// each path is the cheapest one avoiding the nodes and links of the paths
+// before it. A nil weight counts hops; weights must not be negative.
+func (g *Graph) FindDisjointPaths(from, to string, k int, weight WeightFunc) [][]string {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	if weight == nil {
+		weight = func(string, string) float64 { return 1 }
+	}
+
+	var paths [][]string
+	excludedNodes := make(map[string]bool)
+	excludedEdges := make(map[[2]string]bool)
+
+	for len(paths) < k {
+		path := g.shortestPathLocked(from, to, weight, excludedNodes, excludedEdges)
+		if path == nil {
+			break
+		}
+		paths = append(paths, path)
+
+		if len(path) == 1 {
+			// Source is the target, there are no alternatives
+			break
+		}
+
+		for _, node := range path[1 : len(path)-1] {
+			excludedNodes[node] = true
+		}
+		for i := 1; i < len(path); i++ {
+			excludedEdges[[2]string{path[i-1], path[i]}] = true
+			excludedEdges[[2]string{path[i], path[i-1]}] = true
+		}
+	}
+
+	return paths
+}
+
+// shortestPathLocked runs Dijkstra's algorithm from source to target,
+// skipping excluded nodes and edges. Caller must hold g.mu.
+func (g *Graph) shortestPathLocked(
+	from, to string,
+	weight WeightFunc,
+	excludedNodes map[string]bool,
+	excludedEdges map[[2]string]bool,
+) []string {
+	if from == to {
+		return []string{from}
+	}
+
+	dist := map[string]float64{from: 0}
+	prev := make(map[string]string)
+	visited := make(map[string]bool)
+
+	queue := &pathQueue{{node: from}}
+	for queue.Len() > 0 {
+		item := heap.Pop(queue).(pathItem)
+		if visited[item.node] {
+			continue
+		}
+		visited[item.node] = true
+
+		if item.node == to {
+			break
+		}
+
+		for _, neighbor := range g.edges[item.node] {
+			if visited[neighbor] || excludedNodes[neighbor] || excludedEdges[[2]string{item.node, neighbor}] {
+				continue
+			}
+
+			cost := item.cost + weight(item.node, neighbor)
+			if d, ok := dist[neighbor]; !ok || cost < d {
+				dist[neighbor] = cost
+				prev[neighbor] = item.node
+				heap.Push(queue, pathItem{node: neighbor, cost: cost})
+			}
+		}
+	}
+
+	if !visited[to] {
+		// No path found
+		return nil
+	}
+
+	// Walk back from the target
+	path := []string{to}
+	for node := to; node != from; {
+		node = prev[node]
+		path = append(path, node)
+	}
+	slices.Reverse(path)
+
+	return path
+}
+
+// pathItem is a node and its tentative cost in shortestPathLocked
+type pathItem struct {
+	node string
+	cost float64
+}
+
+// pathQueue is a min-heap of pathItems ordered by cost
+type pathQueue []pathItem
+
+func (q pathQueue) Len() int           { return len(q) }
+func (q pathQueue) Less(i, j int) bool { return q[i].cost < q[j].cost }
+func (q pathQueue) Swap(i, j int)      { q[i], q[j] = q[j], q[i] }
+func (q *pathQueue) Push(x any)        { *q = append(*q, x.(pathItem)) }
+func (q *pathQueue) Pop() any {
+	old := *q
+	item := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return item
+}
+
+// Size returns the number of nodes and undirected edges in the graph
+func (g *Graph) Size() (nodes, edges int) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	seen := make(map[[2]string]bool)
+	for node, neighbors := range g.edges {
+		for _, neighbor := range neighbors {
+			edge := [2]string{min(node, neighbor), max(node, neighbor)}
+			if !seen[edge] {
+				seen[edge] = true
+				edges++
+			}
+		}
+	}
+
+	return len(g.edges), edges
+}
+
+// Edges returns a copy of the adjacency list, with each node's neighbors
+// sorted
+func (g *Graph) Edges() map[string][]string {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	edges := make(map[string][]string, len(g.edges))
+	for node, neighbors := range g.edges {
+		edges[node] = slices.Sorted(slices.Values(neighbors))
+	}
+	return edges
+}
+
 // GetNeighbors returns the neighbors of a node
 func (g *Graph) GetNeighbors(node string) []string {
 	g.mu.RLock()
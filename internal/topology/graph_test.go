@@ -0,0 +1,158 @@
+package topology
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGraphUpdate(t *testing.T) {
+	g := NewGraph()
+
+	require.NoError(t, g.Update([]byte(`{"n":"a","nb":["lattice","b"]}`)))
+	require.Equal(t, []string{"lattice", "b"}, g.GetNeighbors("a"))
+
+	// Reverse edges are added
+	require.Equal(t, []string{"a"}, g.GetNeighbors("lattice"))
+	require.Equal(t, []string{"a"}, g.GetNeighbors("b"))
+
+	_, ok := g.LastSeen("a")
+	require.True(t, ok)
+
+	require.Error(t, g.Update([]byte(`not json`)))
+
+	// Edges published from both ends are counted once
+	require.NoError(t, g.Update([]byte(`{"n":"b","nb":["a","c"]}`)))
+	nodes, edges := g.Size()
+	require.Equal(t, 4, nodes)
+	require.Equal(t, 3, edges)
+
+	require.Equal(t, map[string][]string{
+		"a":       {"b", "lattice"},
+		"b":       {"a", "c"},
+		"c":       {"b"},
+		"lattice": {"a"},
+	}, g.Edges())
+}
+
+func TestGraphUpdateReplacesNeighbors(t *testing.T) {
+	g := NewGraph()
+
+	// Reporting [c] after [b] drops the edge to b from both ends
+	require.NoError(t, g.Update([]byte(`{"n":"a","nb":["b"]}`)))
+	require.NoError(t, g.Update([]byte(`{"n":"a","nb":["c"]}`)))
+	require.Equal(t, map[string][]string{
+		"a": {"c"},
+		"c": {"a"},
+	}, g.Edges())
+
+	// Edges reported by other nodes survive the node's own events
+	require.NoError(t, g.Update([]byte(`{"n":"lattice","nb":["a"]}`)))
+	require.NoError(t, g.Update([]byte(`{"n":"a","nb":[]}`)))
+	require.Equal(t, map[string][]string{
+		"a":       {"lattice"},
+		"lattice": {"a"},
+	}, g.Edges())
+
+	// The result doesn't depend on the order of events
+	other := NewGraph()
+	require.NoError(t, other.Update([]byte(`{"n":"a","nb":[]}`)))
+	require.NoError(t, other.Update([]byte(`{"n":"lattice","nb":["a"]}`)))
+	require.Equal(t, g.Edges(), other.Edges())
+}
+
+func TestGraphFindPath(t *testing.T) {
+	g := NewGraph()
+	require.NoError(t, g.Update([]byte(`{"n":"a","nb":["lattice","b"]}`)))
+	require.NoError(t, g.Update([]byte(`{"n":"b","nb":["a","c"]}`)))
+
+	require.Equal(t, []string{"lattice", "a", "b", "c"}, g.FindPath("lattice", "c"))
+	require.Equal(t, []string{"lattice"}, g.FindPath("lattice", "lattice"))
+	require.Nil(t, g.FindPath("lattice", "unknown"))
+}
+
+func TestGraphFindWeightedPath(t *testing.T) {
+	g := NewGraph()
+	require.NoError(t, g.Update([]byte(`{"n":"a","nb":["lattice","c"]}`)))
+	require.NoError(t, g.Update([]byte(`{"n":"b","nb":["lattice","d"]}`)))
+	require.NoError(t, g.Update([]byte(`{"n":"d","nb":["b","c"]}`)))
+
+	// The direct route through a is slow, the longer route through b and d is fast
+	slow := map[string]bool{"lattice-a": true, "a-lattice": true}
+	weight := func(from, to string) float64 {
+		if slow[from+"-"+to] {
+			return 100
+		}
+		return 1
+	}
+
+	require.Equal(t, []string{"lattice", "a", "c"}, g.FindPath("lattice", "c"))
+	require.Equal(t, []string{"lattice", "b", "d", "c"}, g.FindWeightedPath("lattice", "c", weight))
+	require.Equal(t, []string{"lattice"}, g.FindWeightedPath("lattice", "lattice", weight))
+	require.Nil(t, g.FindWeightedPath("lattice", "unknown", weight))
+
+	// A single disjoint path is the weighted shortest path
+	require.Equal(t, [][]string{{"lattice", "b", "d", "c"}}, g.FindDisjointPaths("lattice", "c", 1, weight))
+}
+
+func TestGraphFindDisjointPaths(t *testing.T) {
+	g := NewGraph()
+	require.NoError(t, g.Update([]byte(`{"n":"a","nb":["lattice","c"]}`)))
+	require.NoError(t, g.Update([]byte(`{"n":"b","nb":["lattice","d"]}`)))
+	require.NoError(t, g.Update([]byte(`{"n":"d","nb":["b","c"]}`)))
+	require.NoError(t, g.Update([]byte(`{"n":"e","nb":["a","c"]}`)))
+
+	paths := g.FindDisjointPaths("lattice", "c", 3, nil)
+	require.Equal(t, [][]string{
+		{"lattice", "a", "c"},
+		{"lattice", "b", "d", "c"},
+	}, paths)
+
+	require.Len(t, g.FindDisjointPaths("lattice", "c", 1, nil), 1)
+	require.Empty(t, g.FindDisjointPaths("lattice", "unknown", 3, nil))
+
+	// A direct link is only used once
+	require.NoError(t, g.Update([]byte(`{"n":"c","nb":["lattice","a","d","e"]}`)))
+	paths = g.FindDisjointPaths("lattice", "c", 3, nil)
+	require.Equal(t, []string{"lattice", "c"}, paths[0])
+	require.Len(t, paths, 3)
+}
+
+func TestGraphRemoveNode(t *testing.T) {
+	g := NewGraph()
+	require.NoError(t, g.Update([]byte(`{"n":"a","nb":["lattice","b"]}`)))
+	require.NoError(t, g.Update([]byte(`{"n":"b","nb":["a","c"]}`)))
+
+	require.True(t, g.RemoveNode("a"))
+	require.Empty(t, g.GetNeighbors("a"))
+	require.Empty(t, g.GetNeighbors("lattice"))
+	require.Equal(t, []string{"c"}, g.GetNeighbors("b"))
+	require.Nil(t, g.FindPath("lattice", "c"))
+
+	_, ok := g.LastSeen("a")
+	require.False(t, ok)
+
+	require.False(t, g.RemoveNode("a"))
+}
+
+func TestGraphExpire(t *testing.T) {
+	g := NewGraph()
+	require.NoError(t, g.Update([]byte(`{"n":"a","nb":["lattice","b"]}`)))
+	require.NoError(t, g.Update([]byte(`{"n":"b","nb":["a"]}`)))
+
+	// a has not published for a while
+	g.mu.Lock()
+	g.lastSeen["a"] = time.Now().Add(-10 * time.Minute)
+	g.mu.Unlock()
+
+	expired := g.Expire(5 * time.Minute)
+	require.Equal(t, []string{"a"}, expired)
+	require.Empty(t, g.GetNeighbors("a"))
+	require.Empty(t, g.GetNeighbors("b"))
+	require.Empty(t, g.GetNeighbors("lattice"))
+
+	_, ok := g.LastSeen("b")
+	require.True(t, ok)
+	require.Empty(t, g.Expire(5*time.Minute))
+}
@@ -0,0 +1,182 @@
+package web
+
+import (
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	// connectAllowedHeaders are the request headers used by the Connect,
+	// gRPC-Web and gRPC protocols, always allowed in addition to the
+	// configured headers
+	connectAllowedHeaders = []string{
+		"Content-Type",
+		"Connect-Protocol-Version",
+		"Connect-Timeout-Ms",
+		"Connect-Accept-Encoding",
+		"Connect-Content-Encoding",
+		"Grpc-Timeout",
+		"X-Grpc-Web",
+		"X-User-Agent",
+	}
+
+	// connectExposedHeaders are the response headers browsers need to read
+	// to decode Connect and gRPC-Web responses and errors, and to see the
+	// mesh path a proxied call took
+	connectExposedHeaders = []string{
+		"Connect-Content-Encoding",
+		"Content-Encoding",
+		"Grpc-Status",
+		"Grpc-Message",
+		"Grpc-Status-Details-Bin",
+		"Lattice-Route",
+		"Lattice-Route-Attempts",
+		"Lattice-Route-Error",
+	}
+
+	// defaultAllowedMethods are allowed when no methods are configured.
+	// Connect uses POST for RPCs and GET for idempotent unary calls.
+	defaultAllowedMethods = []string{http.MethodGet, http.MethodPost}
+)
+
+// CORSOptions configures the CORS middleware
+type CORSOptions struct {
+	// AllowedOrigins lists origins allowed to make cross-origin requests.
+	// "*" allows any origin, and "https://*.example.com" allows any
+	// subdomain of example.com.
+	AllowedOrigins []string
+
+	// AllowedMethods lists allowed HTTP methods (default GET and POST)
+	AllowedMethods []string
+
+	// AllowedHeaders lists request headers allowed in addition to the
+	// Connect protocol headers
+	AllowedHeaders []string
+
+	// AllowCredentials allows cookies and HTTP authentication
+	AllowCredentials bool
+
+	// MaxAge is how long browsers may cache preflight results
+	MaxAge time.Duration
+}
+
+// NewCORS wraps next with a middleware that handles CORS preflight requests
+// and adds CORS headers to responses for allowed origins
+func NewCORS(opts CORSOptions, next http.Handler) http.Handler {
+	methods := defaultAllowedMethods
+	if len(opts.AllowedMethods) > 0 {
+		methods = make([]string, len(opts.AllowedMethods))
+		for i, m := range opts.AllowedMethods {
+			methods[i] = strings.ToUpper(m)
+		}
+	}
+
+	headers := append(slices.Clone(connectAllowedHeaders), opts.AllowedHeaders...)
+
+	return &corsHandler{
+		next:           next,
+		opts:           opts,
+		allowedMethods: methods,
+		allowedHeaders: strings.Join(headers, ", "),
+		exposedHeaders: strings.Join(connectExposedHeaders, ", "),
+	}
+}
+
+type corsHandler struct {
+	next           http.Handler
+	opts           CORSOptions
+	allowedMethods []string
+	allowedHeaders string
+	exposedHeaders string
+}
+
+// ServeHTTP implements http.Handler
+func (h *corsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	origin := r.Header.Get("Origin")
+	preflight := r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != ""
+
+	// Responses differ by origin, so caches must key on it
+	w.Header().Add("Vary", "Origin")
+
+	if origin == "" {
+		h.next.ServeHTTP(w, r)
+		return
+	}
+
+	if !h.originAllowed(origin) {
+		if preflight {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		// Let the browser block the response
+		h.next.ServeHTTP(w, r)
+		return
+	}
+
+	h.setOrigin(w, origin)
+
+	if preflight {
+		h.handlePreflight(w, r)
+		return
+	}
+
+	w.Header().Set("Access-Control-Expose-Headers", h.exposedHeaders)
+	h.next.ServeHTTP(w, r)
+}
+
+// handlePreflight responds to an OPTIONS preflight request
+func (h *corsHandler) handlePreflight(w http.ResponseWriter, r *http.Request) {
+	w.Header().Add("Vary", "Access-Control-Request-Method")
+	w.Header().Add("Vary", "Access-Control-Request-Headers")
+
+	method := strings.ToUpper(r.Header.Get("Access-Control-Request-Method"))
+	if !slices.Contains(h.allowedMethods, method) {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	w.Header().Set("Access-Control-Allow-Methods", strings.Join(h.allowedMethods, ", "))
+	w.Header().Set("Access-Control-Allow-Headers", h.allowedHeaders)
+	if h.opts.MaxAge > 0 {
+		w.Header().Set("Access-Control-Max-Age", strconv.Itoa(int(h.opts.MaxAge.Seconds())))
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// setOrigin sets the allowed origin and credentials headers
+func (h *corsHandler) setOrigin(w http.ResponseWriter, origin string) {
+	if slices.Contains(h.opts.AllowedOrigins, "*") && !h.opts.AllowCredentials {
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+	} else {
+		// Browsers reject a wildcard when credentials are allowed
+		w.Header().Set("Access-Control-Allow-Origin", origin)
+	}
+
+	if h.opts.AllowCredentials {
+		w.Header().Set("Access-Control-Allow-Credentials", "true")
+	}
+}
+
+// originAllowed reports whether origin matches one of the allowed origins
+func (h *corsHandler) originAllowed(origin string) bool {
+	origin = strings.ToLower(origin)
+	for _, allowed := range h.opts.AllowedOrigins {
+		allowed = strings.ToLower(allowed)
+		if allowed == "*" || allowed == origin {
+			return true
+		}
+
+		// Wildcard subdomain, e.g. https://*.example.com
+		if prefix, suffix, ok := strings.Cut(allowed, "*"); ok {
+			if strings.HasPrefix(origin, prefix) && strings.HasSuffix(origin, suffix) &&
+				len(origin) > len(prefix)+len(suffix) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,114 @@
+package web
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+var okHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusOK)
+})
+
+func corsRequest(method, origin string, headers map[string]string) *http.Request {
+	req := httptest.NewRequest(method, "/observer.v1.ObserverService/GetTopology", nil)
+	if origin != "" {
+		req.Header.Set("Origin", origin)
+	}
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	return req
+}
+
+func TestCORSPreflight(t *testing.T) {
+	handler := NewCORS(CORSOptions{
+		AllowedOrigins: []string{"http://localhost:3000"},
+		MaxAge:         10 * time.Minute,
+	}, okHandler)
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, corsRequest(http.MethodOptions, "http://localhost:3000", map[string]string{
+		"Access-Control-Request-Method":  "POST",
+		"Access-Control-Request-Headers": "content-type,connect-protocol-version",
+	}))
+
+	require.Equal(t, http.StatusNoContent, rec.Code)
+	require.Equal(t, "http://localhost:3000", rec.Header().Get("Access-Control-Allow-Origin"))
+	require.Equal(t, "GET, POST", rec.Header().Get("Access-Control-Allow-Methods"))
+	require.Contains(t, rec.Header().Get("Access-Control-Allow-Headers"), "Connect-Protocol-Version")
+	require.Equal(t, "600", rec.Header().Get("Access-Control-Max-Age"))
+	require.Empty(t, rec.Header().Get("Access-Control-Allow-Credentials"))
+}
+
+func TestCORSPreflightDisallowed(t *testing.T) {
+	handler := NewCORS(CORSOptions{
+		AllowedOrigins: []string{"http://localhost:3000"},
+	}, okHandler)
+
+	// Unknown origin
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, corsRequest(http.MethodOptions, "http://evil.example", map[string]string{
+		"Access-Control-Request-Method": "POST",
+	}))
+	require.Equal(t, http.StatusForbidden, rec.Code)
+	require.Empty(t, rec.Header().Get("Access-Control-Allow-Origin"))
+
+	// Method not allowed
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, corsRequest(http.MethodOptions, "http://localhost:3000", map[string]string{
+		"Access-Control-Request-Method": "DELETE",
+	}))
+	require.Equal(t, http.StatusForbidden, rec.Code)
+}
+
+func TestCORSActualRequest(t *testing.T) {
+	handler := NewCORS(CORSOptions{
+		AllowedOrigins:   []string{"https://*.example.com"},
+		AllowCredentials: true,
+	}, okHandler)
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, corsRequest(http.MethodPost, "https://portal.example.com", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "https://portal.example.com", rec.Header().Get("Access-Control-Allow-Origin"))
+	require.Equal(t, "true", rec.Header().Get("Access-Control-Allow-Credentials"))
+	require.Contains(t, rec.Header().Get("Access-Control-Expose-Headers"), "Grpc-Status")
+	require.Contains(t, rec.Header().Get("Access-Control-Expose-Headers"), "Lattice-Route-Attempts")
+	require.Contains(t, rec.Header().Values("Vary"), "Origin")
+
+	// Disallowed origins are passed through without CORS headers
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, corsRequest(http.MethodPost, "https://example.org", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Empty(t, rec.Header().Get("Access-Control-Allow-Origin"))
+}
+
+func TestCORSWildcardOrigin(t *testing.T) {
+	handler := NewCORS(CORSOptions{
+		AllowedOrigins: []string{"*"},
+		AllowedMethods: []string{"post"},
+	}, okHandler)
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, corsRequest(http.MethodOptions, "http://anything.test", map[string]string{
+		"Access-Control-Request-Method": "POST",
+	}))
+	require.Equal(t, http.StatusNoContent, rec.Code)
+	require.Equal(t, "*", rec.Header().Get("Access-Control-Allow-Origin"))
+	require.Equal(t, "POST", rec.Header().Get("Access-Control-Allow-Methods"))
+}
+
+func TestCORSSameOrigin(t *testing.T) {
+	handler := NewCORS(CORSOptions{
+		AllowedOrigins: []string{"http://localhost:3000"},
+	}, okHandler)
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, corsRequest(http.MethodPost, "", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Empty(t, rec.Header().Get("Access-Control-Allow-Origin"))
+}
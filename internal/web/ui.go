@@ -0,0 +1,107 @@
+package web
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/fs"
+	"net/http"
+	"path"
+	"strings"
+)
+
+const (
+	// indexFile is served for the root path and for client-side routes
+	indexFile = "index.html"
+
+	// assetsDir contains Vite's content-hashed build output
+	assetsDir = "assets/"
+
+	// immutableCacheControl is used for hashed assets, which never change
+	immutableCacheControl = "public, max-age=31536000, immutable"
+
+	// revalidateCacheControl is used for everything else, so a new build is
+	// picked up on the next page load
+	revalidateCacheControl = "no-cache"
+)
+
+// NewUIHandler returns a handler that serves the web UI from fsys.
+// Requests for paths without a file extension that don't match a file fall
+// back to index.html so client-side routes resolve.
+func NewUIHandler(fsys fs.FS) http.Handler {
+	return &uiHandler{fsys: fsys}
+}
+
+type uiHandler struct {
+	fsys fs.FS
+}
+
+// ServeHTTP implements http.Handler
+func (h *uiHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	name := strings.TrimPrefix(path.Clean("/"+r.URL.Path), "/")
+	if name == "" {
+		name = indexFile
+	}
+
+	err := h.serveFile(w, r, name)
+	if errors.Is(err, fs.ErrNotExist) && path.Ext(name) == "" {
+		// Client-side route, let the SPA handle it
+		name = indexFile
+		err = h.serveFile(w, r, name)
+	}
+
+	switch {
+	case errors.Is(err, fs.ErrNotExist) && name == indexFile:
+		http.Error(w, "web UI is not built; run `npm run build` in ui/", http.StatusNotFound)
+	case errors.Is(err, fs.ErrNotExist):
+		http.NotFound(w, r)
+	case err != nil:
+		http.Error(w, "failed to read file", http.StatusInternalServerError)
+	}
+}
+
+// serveFile writes the named file with cache headers. It returns
+// fs.ErrNotExist without writing anything if the file is missing or is a
+// directory.
+func (h *uiHandler) serveFile(w http.ResponseWriter, r *http.Request, name string) error {
+	f, err := h.fsys.Open(name)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+
+	if info.IsDir() {
+		return fs.ErrNotExist
+	}
+
+	if strings.HasPrefix(name, assetsDir) {
+		w.Header().Set("Cache-Control", immutableCacheControl)
+	} else {
+		w.Header().Set("Cache-Control", revalidateCacheControl)
+	}
+
+	// Embedded files implement io.ReadSeeker; fall back to buffering if the
+	// filesystem does not
+	content, ok := f.(io.ReadSeeker)
+	if !ok {
+		data, err := io.ReadAll(f)
+		if err != nil {
+			return err
+		}
+		content = bytes.NewReader(data)
+	}
+
+	http.ServeContent(w, r, name, info.ModTime(), content)
+	return nil
+}
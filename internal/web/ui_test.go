@@ -0,0 +1,80 @@
+package web
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testUIFS() fstest.MapFS {
+	return fstest.MapFS{
+		"index.html":            {Data: []byte("<html>index</html>")},
+		"favicon.svg":           {Data: []byte("<svg/>")},
+		"assets/index-abc12.js": {Data: []byte("console.log('ui')")},
+	}
+}
+
+func serveUI(t *testing.T, handler http.Handler, method, target string) *httptest.ResponseRecorder {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(method, target, nil))
+	return rec
+}
+
+func TestUIHandlerServesIndex(t *testing.T) {
+	handler := NewUIHandler(testUIFS())
+
+	rec := serveUI(t, handler, http.MethodGet, "/")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "<html>index</html>", rec.Body.String())
+	require.Equal(t, revalidateCacheControl, rec.Header().Get("Cache-Control"))
+	require.Contains(t, rec.Header().Get("Content-Type"), "text/html")
+}
+
+func TestUIHandlerServesHashedAssets(t *testing.T) {
+	handler := NewUIHandler(testUIFS())
+
+	rec := serveUI(t, handler, http.MethodGet, "/assets/index-abc12.js")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "console.log('ui')", rec.Body.String())
+	require.Equal(t, immutableCacheControl, rec.Header().Get("Cache-Control"))
+
+	rec = serveUI(t, handler, http.MethodGet, "/favicon.svg")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, revalidateCacheControl, rec.Header().Get("Cache-Control"))
+}
+
+func TestUIHandlerSPAFallback(t *testing.T) {
+	handler := NewUIHandler(testUIFS())
+
+	rec := serveUI(t, handler, http.MethodGet, "/services/user-service")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "<html>index</html>", rec.Body.String())
+
+	// Missing files with an extension are not routes
+	rec = serveUI(t, handler, http.MethodGet, "/assets/missing.js")
+	require.Equal(t, http.StatusNotFound, rec.Code)
+
+	// Directories fall back to the index too
+	rec = serveUI(t, handler, http.MethodGet, "/assets")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "<html>index</html>", rec.Body.String())
+}
+
+func TestUIHandlerNotBuilt(t *testing.T) {
+	handler := NewUIHandler(fstest.MapFS{".gitkeep": {}})
+
+	rec := serveUI(t, handler, http.MethodGet, "/")
+	require.Equal(t, http.StatusNotFound, rec.Code)
+	require.Contains(t, rec.Body.String(), "not built")
+}
+
+func TestUIHandlerRejectsWrites(t *testing.T) {
+	handler := NewUIHandler(testUIFS())
+
+	rec := serveUI(t, handler, http.MethodPost, "/")
+	require.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+}
@@ -0,0 +1,209 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: observer/v1/keyring.proto
+
+package observerapiconnect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/jumppad-labs/lattice/pkg/api/observer/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// KeyringServiceName is the fully-qualified name of the KeyringService service.
+	KeyringServiceName = "observer.v1.KeyringService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// KeyringServiceListKeysProcedure is the fully-qualified name of the KeyringService's ListKeys RPC.
+	KeyringServiceListKeysProcedure = "/observer.v1.KeyringService/ListKeys"
+	// KeyringServiceInstallKeyProcedure is the fully-qualified name of the KeyringService's InstallKey
+	// RPC.
+	KeyringServiceInstallKeyProcedure = "/observer.v1.KeyringService/InstallKey"
+	// KeyringServiceUseKeyProcedure is the fully-qualified name of the KeyringService's UseKey RPC.
+	KeyringServiceUseKeyProcedure = "/observer.v1.KeyringService/UseKey"
+	// KeyringServiceRemoveKeyProcedure is the fully-qualified name of the KeyringService's RemoveKey
+	// RPC.
+	KeyringServiceRemoveKeyProcedure = "/observer.v1.KeyringService/RemoveKey"
+)
+
+// These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
+var (
+	keyringServiceServiceDescriptor          = v1.File_observer_v1_keyring_proto.Services().ByName("KeyringService")
+	keyringServiceListKeysMethodDescriptor   = keyringServiceServiceDescriptor.Methods().ByName("ListKeys")
+	keyringServiceInstallKeyMethodDescriptor = keyringServiceServiceDescriptor.Methods().ByName("InstallKey")
+	keyringServiceUseKeyMethodDescriptor     = keyringServiceServiceDescriptor.Methods().ByName("UseKey")
+	keyringServiceRemoveKeyMethodDescriptor  = keyringServiceServiceDescriptor.Methods().ByName("RemoveKey")
+)
+
+// KeyringServiceClient is a client for the observer.v1.KeyringService service.
+type KeyringServiceClient interface {
+	// ListKeys lists the keys installed on members of the mesh
+	ListKeys(context.Context, *connect.Request[v1.ListKeysRequest]) (*connect.Response[v1.ListKeysResponse], error)
+	// InstallKey installs a new key on all members of the mesh
+	InstallKey(context.Context, *connect.Request[v1.InstallKeyRequest]) (*connect.Response[v1.InstallKeyResponse], error)
+	// UseKey changes the primary key used to encrypt gossip messages
+	UseKey(context.Context, *connect.Request[v1.UseKeyRequest]) (*connect.Response[v1.UseKeyResponse], error)
+	// RemoveKey removes a key from all members of the mesh
+	RemoveKey(context.Context, *connect.Request[v1.RemoveKeyRequest]) (*connect.Response[v1.RemoveKeyResponse], error)
+}
+
+// NewKeyringServiceClient constructs a client for the observer.v1.KeyringService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewKeyringServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) KeyringServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &keyringServiceClient{
+		listKeys: connect.NewClient[v1.ListKeysRequest, v1.ListKeysResponse](
+			httpClient,
+			baseURL+KeyringServiceListKeysProcedure,
+			connect.WithSchema(keyringServiceListKeysMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		installKey: connect.NewClient[v1.InstallKeyRequest, v1.InstallKeyResponse](
+			httpClient,
+			baseURL+KeyringServiceInstallKeyProcedure,
+			connect.WithSchema(keyringServiceInstallKeyMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		useKey: connect.NewClient[v1.UseKeyRequest, v1.UseKeyResponse](
+			httpClient,
+			baseURL+KeyringServiceUseKeyProcedure,
+			connect.WithSchema(keyringServiceUseKeyMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		removeKey: connect.NewClient[v1.RemoveKeyRequest, v1.RemoveKeyResponse](
+			httpClient,
+			baseURL+KeyringServiceRemoveKeyProcedure,
+			connect.WithSchema(keyringServiceRemoveKeyMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// keyringServiceClient implements KeyringServiceClient.
+type keyringServiceClient struct {
+	listKeys   *connect.Client[v1.ListKeysRequest, v1.ListKeysResponse]
+	installKey *connect.Client[v1.InstallKeyRequest, v1.InstallKeyResponse]
+	useKey     *connect.Client[v1.UseKeyRequest, v1.UseKeyResponse]
+	removeKey  *connect.Client[v1.RemoveKeyRequest, v1.RemoveKeyResponse]
+}
+
+// ListKeys calls observer.v1.KeyringService.ListKeys.
+func (c *keyringServiceClient) ListKeys(ctx context.Context, req *connect.Request[v1.ListKeysRequest]) (*connect.Response[v1.ListKeysResponse], error) {
+	return c.listKeys.CallUnary(ctx, req)
+}
+
+// InstallKey calls observer.v1.KeyringService.InstallKey.
+func (c *keyringServiceClient) InstallKey(ctx context.Context, req *connect.Request[v1.InstallKeyRequest]) (*connect.Response[v1.InstallKeyResponse], error) {
+	return c.installKey.CallUnary(ctx, req)
+}
+
+// UseKey calls observer.v1.KeyringService.UseKey.
+func (c *keyringServiceClient) UseKey(ctx context.Context, req *connect.Request[v1.UseKeyRequest]) (*connect.Response[v1.UseKeyResponse], error) {
+	return c.useKey.CallUnary(ctx, req)
+}
+
+// RemoveKey calls observer.v1.KeyringService.RemoveKey.
+func (c *keyringServiceClient) RemoveKey(ctx context.Context, req *connect.Request[v1.RemoveKeyRequest]) (*connect.Response[v1.RemoveKeyResponse], error) {
+	return c.removeKey.CallUnary(ctx, req)
+}
+
+// KeyringServiceHandler is an implementation of the observer.v1.KeyringService service.
+type KeyringServiceHandler interface {
+	// ListKeys lists the keys installed on members of the mesh
+	ListKeys(context.Context, *connect.Request[v1.ListKeysRequest]) (*connect.Response[v1.ListKeysResponse], error)
+	// InstallKey installs a new key on all members of the mesh
+	InstallKey(context.Context, *connect.Request[v1.InstallKeyRequest]) (*connect.Response[v1.InstallKeyResponse], error)
+	// UseKey changes the primary key used to encrypt gossip messages
+	UseKey(context.Context, *connect.Request[v1.UseKeyRequest]) (*connect.Response[v1.UseKeyResponse], error)
+	// RemoveKey removes a key from all members of the mesh
+	RemoveKey(context.Context, *connect.Request[v1.RemoveKeyRequest]) (*connect.Response[v1.RemoveKeyResponse], error)
+}
+
+// NewKeyringServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewKeyringServiceHandler(svc KeyringServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	keyringServiceListKeysHandler := connect.NewUnaryHandler(
+		KeyringServiceListKeysProcedure,
+		svc.ListKeys,
+		connect.WithSchema(keyringServiceListKeysMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	keyringServiceInstallKeyHandler := connect.NewUnaryHandler(
+		KeyringServiceInstallKeyProcedure,
+		svc.InstallKey,
+		connect.WithSchema(keyringServiceInstallKeyMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	keyringServiceUseKeyHandler := connect.NewUnaryHandler(
+		KeyringServiceUseKeyProcedure,
+		svc.UseKey,
+		connect.WithSchema(keyringServiceUseKeyMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	keyringServiceRemoveKeyHandler := connect.NewUnaryHandler(
+		KeyringServiceRemoveKeyProcedure,
+		svc.RemoveKey,
+		connect.WithSchema(keyringServiceRemoveKeyMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/observer.v1.KeyringService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case KeyringServiceListKeysProcedure:
+			keyringServiceListKeysHandler.ServeHTTP(w, r)
+		case KeyringServiceInstallKeyProcedure:
+			keyringServiceInstallKeyHandler.ServeHTTP(w, r)
+		case KeyringServiceUseKeyProcedure:
+			keyringServiceUseKeyHandler.ServeHTTP(w, r)
+		case KeyringServiceRemoveKeyProcedure:
+			keyringServiceRemoveKeyHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedKeyringServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedKeyringServiceHandler struct{}
+
+func (UnimplementedKeyringServiceHandler) ListKeys(context.Context, *connect.Request[v1.ListKeysRequest]) (*connect.Response[v1.ListKeysResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("observer.v1.KeyringService.ListKeys is not implemented"))
+}
+
+func (UnimplementedKeyringServiceHandler) InstallKey(context.Context, *connect.Request[v1.InstallKeyRequest]) (*connect.Response[v1.InstallKeyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("observer.v1.KeyringService.InstallKey is not implemented"))
+}
+
+func (UnimplementedKeyringServiceHandler) UseKey(context.Context, *connect.Request[v1.UseKeyRequest]) (*connect.Response[v1.UseKeyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("observer.v1.KeyringService.UseKey is not implemented"))
+}
+
+func (UnimplementedKeyringServiceHandler) RemoveKey(context.Context, *connect.Request[v1.RemoveKeyRequest]) (*connect.Response[v1.RemoveKeyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("observer.v1.KeyringService.RemoveKey is not implemented"))
+}
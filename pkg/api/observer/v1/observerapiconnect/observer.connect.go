@@ -45,15 +45,47 @@ const (
 	// ObserverServiceGetRequestLogsProcedure is the fully-qualified name of the ObserverService's
 	// GetRequestLogs RPC.
 	ObserverServiceGetRequestLogsProcedure = "/observer.v1.ObserverService/GetRequestLogs"
+	// ObserverServiceWatchRequestLogsProcedure is the fully-qualified name of the ObserverService's
+	// WatchRequestLogs RPC.
+	ObserverServiceWatchRequestLogsProcedure = "/observer.v1.ObserverService/WatchRequestLogs"
+	// ObserverServiceGetAggregatedRequestLogsProcedure is the fully-qualified name of the
+	// ObserverService's GetAggregatedRequestLogs RPC.
+	ObserverServiceGetAggregatedRequestLogsProcedure = "/observer.v1.ObserverService/GetAggregatedRequestLogs"
+	// ObserverServiceSearchRequestLogsProcedure is the fully-qualified name of the ObserverService's
+	// SearchRequestLogs RPC.
+	ObserverServiceSearchRequestLogsProcedure = "/observer.v1.ObserverService/SearchRequestLogs"
+	// ObserverServiceGetServiceMetricsProcedure is the fully-qualified name of the ObserverService's
+	// GetServiceMetrics RPC.
+	ObserverServiceGetServiceMetricsProcedure = "/observer.v1.ObserverService/GetServiceMetrics"
+	// ObserverServiceGetTopologyAtProcedure is the fully-qualified name of the ObserverService's
+	// GetTopologyAt RPC.
+	ObserverServiceGetTopologyAtProcedure = "/observer.v1.ObserverService/GetTopologyAt"
+	// ObserverServiceListTopologyChangesProcedure is the fully-qualified name of the ObserverService's
+	// ListTopologyChanges RPC.
+	ObserverServiceListTopologyChangesProcedure = "/observer.v1.ObserverService/ListTopologyChanges"
+	// ObserverServiceDiffTopologyProcedure is the fully-qualified name of the ObserverService's
+	// DiffTopology RPC.
+	ObserverServiceDiffTopologyProcedure = "/observer.v1.ObserverService/DiffTopology"
+	// ObserverServiceListMembersProcedure is the fully-qualified name of the ObserverService's
+	// ListMembers RPC.
+	ObserverServiceListMembersProcedure = "/observer.v1.ObserverService/ListMembers"
 )
 
 // These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
 var (
-	observerServiceServiceDescriptor                   = v1.File_observer_v1_observer_proto.Services().ByName("ObserverService")
-	observerServiceGetTopologyMethodDescriptor         = observerServiceServiceDescriptor.Methods().ByName("GetTopology")
-	observerServiceWatchTopologyMethodDescriptor       = observerServiceServiceDescriptor.Methods().ByName("WatchTopology")
-	observerServiceGetServiceResourcesMethodDescriptor = observerServiceServiceDescriptor.Methods().ByName("GetServiceResources")
-	observerServiceGetRequestLogsMethodDescriptor      = observerServiceServiceDescriptor.Methods().ByName("GetRequestLogs")
+	observerServiceServiceDescriptor                        = v1.File_observer_v1_observer_proto.Services().ByName("ObserverService")
+	observerServiceGetTopologyMethodDescriptor              = observerServiceServiceDescriptor.Methods().ByName("GetTopology")
+	observerServiceWatchTopologyMethodDescriptor            = observerServiceServiceDescriptor.Methods().ByName("WatchTopology")
+	observerServiceGetServiceResourcesMethodDescriptor      = observerServiceServiceDescriptor.Methods().ByName("GetServiceResources")
+	observerServiceGetRequestLogsMethodDescriptor           = observerServiceServiceDescriptor.Methods().ByName("GetRequestLogs")
+	observerServiceWatchRequestLogsMethodDescriptor         = observerServiceServiceDescriptor.Methods().ByName("WatchRequestLogs")
+	observerServiceGetAggregatedRequestLogsMethodDescriptor = observerServiceServiceDescriptor.Methods().ByName("GetAggregatedRequestLogs")
+	observerServiceSearchRequestLogsMethodDescriptor        = observerServiceServiceDescriptor.Methods().ByName("SearchRequestLogs")
+	observerServiceGetServiceMetricsMethodDescriptor        = observerServiceServiceDescriptor.Methods().ByName("GetServiceMetrics")
+	observerServiceGetTopologyAtMethodDescriptor            = observerServiceServiceDescriptor.Methods().ByName("GetTopologyAt")
+	observerServiceListTopologyChangesMethodDescriptor      = observerServiceServiceDescriptor.Methods().ByName("ListTopologyChanges")
+	observerServiceDiffTopologyMethodDescriptor             = observerServiceServiceDescriptor.Methods().ByName("DiffTopology")
+	observerServiceListMembersMethodDescriptor              = observerServiceServiceDescriptor.Methods().ByName("ListMembers")
 )
 
 // ObserverServiceClient is a client for the observer.v1.ObserverService service.
@@ -66,6 +98,27 @@ type ObserverServiceClient interface {
 	GetServiceResources(context.Context, *connect.Request[v1.GetServiceResourcesRequest]) (*connect.Response[v1.GetServiceResourcesResponse], error)
 	// GetRequestLogs fetches recent HTTP request logs for a service
 	GetRequestLogs(context.Context, *connect.Request[v1.GetRequestLogsRequest]) (*connect.Response[v1.GetRequestLogsResponse], error)
+	// WatchRequestLogs streams new HTTP request logs for a service
+	WatchRequestLogs(context.Context, *connect.Request[v1.WatchRequestLogsRequest]) (*connect.ServerStreamForClient[v1.WatchRequestLogsResponse], error)
+	// GetAggregatedRequestLogs fetches request logs from several services and
+	// merges them by timestamp
+	GetAggregatedRequestLogs(context.Context, *connect.Request[v1.GetAggregatedRequestLogsRequest]) (*connect.Response[v1.GetAggregatedRequestLogsResponse], error)
+	// SearchRequestLogs searches request logs retained by Lattice, including
+	// logs from services that have since restarted or left the mesh
+	SearchRequestLogs(context.Context, *connect.Request[v1.SearchRequestLogsRequest]) (*connect.Response[v1.SearchRequestLogsResponse], error)
+	// GetServiceMetrics returns rate, error and latency metrics per service
+	// and route, computed from collected request logs
+	GetServiceMetrics(context.Context, *connect.Request[v1.GetServiceMetricsRequest]) (*connect.Response[v1.GetServiceMetricsResponse], error)
+	// GetTopologyAt returns the topology as it was at a point in time, from
+	// the recorded topology history
+	GetTopologyAt(context.Context, *connect.Request[v1.GetTopologyAtRequest]) (*connect.Response[v1.GetTopologyAtResponse], error)
+	// ListTopologyChanges lists the recorded topology revisions in a time range
+	ListTopologyChanges(context.Context, *connect.Request[v1.ListTopologyChangesRequest]) (*connect.Response[v1.ListTopologyChangesResponse], error)
+	// DiffTopology compares two topologies, each the live topology, a recorded
+	// revision or a snapshot supplied by the caller
+	DiffTopology(context.Context, *connect.Request[v1.DiffTopologyRequest]) (*connect.Response[v1.DiffTopologyResponse], error)
+	// ListMembers lists the nodes in the gossip mesh
+	ListMembers(context.Context, *connect.Request[v1.ListMembersRequest]) (*connect.Response[v1.ListMembersResponse], error)
 }
 
 // NewObserverServiceClient constructs a client for the observer.v1.ObserverService service. By
@@ -102,15 +155,71 @@ func NewObserverServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 			connect.WithSchema(observerServiceGetRequestLogsMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		watchRequestLogs: connect.NewClient[v1.WatchRequestLogsRequest, v1.WatchRequestLogsResponse](
+			httpClient,
+			baseURL+ObserverServiceWatchRequestLogsProcedure,
+			connect.WithSchema(observerServiceWatchRequestLogsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getAggregatedRequestLogs: connect.NewClient[v1.GetAggregatedRequestLogsRequest, v1.GetAggregatedRequestLogsResponse](
+			httpClient,
+			baseURL+ObserverServiceGetAggregatedRequestLogsProcedure,
+			connect.WithSchema(observerServiceGetAggregatedRequestLogsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		searchRequestLogs: connect.NewClient[v1.SearchRequestLogsRequest, v1.SearchRequestLogsResponse](
+			httpClient,
+			baseURL+ObserverServiceSearchRequestLogsProcedure,
+			connect.WithSchema(observerServiceSearchRequestLogsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getServiceMetrics: connect.NewClient[v1.GetServiceMetricsRequest, v1.GetServiceMetricsResponse](
+			httpClient,
+			baseURL+ObserverServiceGetServiceMetricsProcedure,
+			connect.WithSchema(observerServiceGetServiceMetricsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getTopologyAt: connect.NewClient[v1.GetTopologyAtRequest, v1.GetTopologyAtResponse](
+			httpClient,
+			baseURL+ObserverServiceGetTopologyAtProcedure,
+			connect.WithSchema(observerServiceGetTopologyAtMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listTopologyChanges: connect.NewClient[v1.ListTopologyChangesRequest, v1.ListTopologyChangesResponse](
+			httpClient,
+			baseURL+ObserverServiceListTopologyChangesProcedure,
+			connect.WithSchema(observerServiceListTopologyChangesMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		diffTopology: connect.NewClient[v1.DiffTopologyRequest, v1.DiffTopologyResponse](
+			httpClient,
+			baseURL+ObserverServiceDiffTopologyProcedure,
+			connect.WithSchema(observerServiceDiffTopologyMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listMembers: connect.NewClient[v1.ListMembersRequest, v1.ListMembersResponse](
+			httpClient,
+			baseURL+ObserverServiceListMembersProcedure,
+			connect.WithSchema(observerServiceListMembersMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // observerServiceClient implements ObserverServiceClient.
 type observerServiceClient struct {
-	getTopology         *connect.Client[v1.GetTopologyRequest, v1.GetTopologyResponse]
-	watchTopology       *connect.Client[v1.WatchTopologyRequest, v1.TopologyUpdate]
-	getServiceResources *connect.Client[v1.GetServiceResourcesRequest, v1.GetServiceResourcesResponse]
-	getRequestLogs      *connect.Client[v1.GetRequestLogsRequest, v1.GetRequestLogsResponse]
+	getTopology              *connect.Client[v1.GetTopologyRequest, v1.GetTopologyResponse]
+	watchTopology            *connect.Client[v1.WatchTopologyRequest, v1.TopologyUpdate]
+	getServiceResources      *connect.Client[v1.GetServiceResourcesRequest, v1.GetServiceResourcesResponse]
+	getRequestLogs           *connect.Client[v1.GetRequestLogsRequest, v1.GetRequestLogsResponse]
+	watchRequestLogs         *connect.Client[v1.WatchRequestLogsRequest, v1.WatchRequestLogsResponse]
+	getAggregatedRequestLogs *connect.Client[v1.GetAggregatedRequestLogsRequest, v1.GetAggregatedRequestLogsResponse]
+	searchRequestLogs        *connect.Client[v1.SearchRequestLogsRequest, v1.SearchRequestLogsResponse]
+	getServiceMetrics        *connect.Client[v1.GetServiceMetricsRequest, v1.GetServiceMetricsResponse]
+	getTopologyAt            *connect.Client[v1.GetTopologyAtRequest, v1.GetTopologyAtResponse]
+	listTopologyChanges      *connect.Client[v1.ListTopologyChangesRequest, v1.ListTopologyChangesResponse]
+	diffTopology             *connect.Client[v1.DiffTopologyRequest, v1.DiffTopologyResponse]
+	listMembers              *connect.Client[v1.ListMembersRequest, v1.ListMembersResponse]
 }
 
 // GetTopology calls observer.v1.ObserverService.GetTopology.
@@ -133,6 +242,46 @@ func (c *observerServiceClient) GetRequestLogs(ctx context.Context, req *connect
 	return c.getRequestLogs.CallUnary(ctx, req)
 }
 
+// WatchRequestLogs calls observer.v1.ObserverService.WatchRequestLogs.
+func (c *observerServiceClient) WatchRequestLogs(ctx context.Context, req *connect.Request[v1.WatchRequestLogsRequest]) (*connect.ServerStreamForClient[v1.WatchRequestLogsResponse], error) {
+	return c.watchRequestLogs.CallServerStream(ctx, req)
+}
+
+// GetAggregatedRequestLogs calls observer.v1.ObserverService.GetAggregatedRequestLogs.
+func (c *observerServiceClient) GetAggregatedRequestLogs(ctx context.Context, req *connect.Request[v1.GetAggregatedRequestLogsRequest]) (*connect.Response[v1.GetAggregatedRequestLogsResponse], error) {
+	return c.getAggregatedRequestLogs.CallUnary(ctx, req)
+}
+
+// SearchRequestLogs calls observer.v1.ObserverService.SearchRequestLogs.
+func (c *observerServiceClient) SearchRequestLogs(ctx context.Context, req *connect.Request[v1.SearchRequestLogsRequest]) (*connect.Response[v1.SearchRequestLogsResponse], error) {
+	return c.searchRequestLogs.CallUnary(ctx, req)
+}
+
+// GetServiceMetrics calls observer.v1.ObserverService.GetServiceMetrics.
+func (c *observerServiceClient) GetServiceMetrics(ctx context.Context, req *connect.Request[v1.GetServiceMetricsRequest]) (*connect.Response[v1.GetServiceMetricsResponse], error) {
+	return c.getServiceMetrics.CallUnary(ctx, req)
+}
+
+// GetTopologyAt calls observer.v1.ObserverService.GetTopologyAt.
+func (c *observerServiceClient) GetTopologyAt(ctx context.Context, req *connect.Request[v1.GetTopologyAtRequest]) (*connect.Response[v1.GetTopologyAtResponse], error) {
+	return c.getTopologyAt.CallUnary(ctx, req)
+}
+
+// ListTopologyChanges calls observer.v1.ObserverService.ListTopologyChanges.
+func (c *observerServiceClient) ListTopologyChanges(ctx context.Context, req *connect.Request[v1.ListTopologyChangesRequest]) (*connect.Response[v1.ListTopologyChangesResponse], error) {
+	return c.listTopologyChanges.CallUnary(ctx, req)
+}
+
+// DiffTopology calls observer.v1.ObserverService.DiffTopology.
+func (c *observerServiceClient) DiffTopology(ctx context.Context, req *connect.Request[v1.DiffTopologyRequest]) (*connect.Response[v1.DiffTopologyResponse], error) {
+	return c.diffTopology.CallUnary(ctx, req)
+}
+
+// ListMembers calls observer.v1.ObserverService.ListMembers.
+func (c *observerServiceClient) ListMembers(ctx context.Context, req *connect.Request[v1.ListMembersRequest]) (*connect.Response[v1.ListMembersResponse], error) {
+	return c.listMembers.CallUnary(ctx, req)
+}
+
 // ObserverServiceHandler is an implementation of the observer.v1.ObserverService service.
 type ObserverServiceHandler interface {
 	// GetTopology returns the current topology snapshot
@@ -143,6 +292,27 @@ type ObserverServiceHandler interface {
 	GetServiceResources(context.Context, *connect.Request[v1.GetServiceResourcesRequest]) (*connect.Response[v1.GetServiceResourcesResponse], error)
 	// GetRequestLogs fetches recent HTTP request logs for a service
 	GetRequestLogs(context.Context, *connect.Request[v1.GetRequestLogsRequest]) (*connect.Response[v1.GetRequestLogsResponse], error)
+	// WatchRequestLogs streams new HTTP request logs for a service
+	WatchRequestLogs(context.Context, *connect.Request[v1.WatchRequestLogsRequest], *connect.ServerStream[v1.WatchRequestLogsResponse]) error
+	// GetAggregatedRequestLogs fetches request logs from several services and
+	// merges them by timestamp
+	GetAggregatedRequestLogs(context.Context, *connect.Request[v1.GetAggregatedRequestLogsRequest]) (*connect.Response[v1.GetAggregatedRequestLogsResponse], error)
+	// SearchRequestLogs searches request logs retained by Lattice, including
+	// logs from services that have since restarted or left the mesh
+	SearchRequestLogs(context.Context, *connect.Request[v1.SearchRequestLogsRequest]) (*connect.Response[v1.SearchRequestLogsResponse], error)
+	// GetServiceMetrics returns rate, error and latency metrics per service
+	// and route, computed from collected request logs
+	GetServiceMetrics(context.Context, *connect.Request[v1.GetServiceMetricsRequest]) (*connect.Response[v1.GetServiceMetricsResponse], error)
+	// GetTopologyAt returns the topology as it was at a point in time, from
+	// the recorded topology history
+	GetTopologyAt(context.Context, *connect.Request[v1.GetTopologyAtRequest]) (*connect.Response[v1.GetTopologyAtResponse], error)
+	// ListTopologyChanges lists the recorded topology revisions in a time range
+	ListTopologyChanges(context.Context, *connect.Request[v1.ListTopologyChangesRequest]) (*connect.Response[v1.ListTopologyChangesResponse], error)
+	// DiffTopology compares two topologies, each the live topology, a recorded
+	// revision or a snapshot supplied by the caller
+	DiffTopology(context.Context, *connect.Request[v1.DiffTopologyRequest]) (*connect.Response[v1.DiffTopologyResponse], error)
+	// ListMembers lists the nodes in the gossip mesh
+	ListMembers(context.Context, *connect.Request[v1.ListMembersRequest]) (*connect.Response[v1.ListMembersResponse], error)
 }
 
 // NewObserverServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -175,6 +345,54 @@ func NewObserverServiceHandler(svc ObserverServiceHandler, opts ...connect.Handl
 		connect.WithSchema(observerServiceGetRequestLogsMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	observerServiceWatchRequestLogsHandler := connect.NewServerStreamHandler(
+		ObserverServiceWatchRequestLogsProcedure,
+		svc.WatchRequestLogs,
+		connect.WithSchema(observerServiceWatchRequestLogsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	observerServiceGetAggregatedRequestLogsHandler := connect.NewUnaryHandler(
+		ObserverServiceGetAggregatedRequestLogsProcedure,
+		svc.GetAggregatedRequestLogs,
+		connect.WithSchema(observerServiceGetAggregatedRequestLogsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	observerServiceSearchRequestLogsHandler := connect.NewUnaryHandler(
+		ObserverServiceSearchRequestLogsProcedure,
+		svc.SearchRequestLogs,
+		connect.WithSchema(observerServiceSearchRequestLogsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	observerServiceGetServiceMetricsHandler := connect.NewUnaryHandler(
+		ObserverServiceGetServiceMetricsProcedure,
+		svc.GetServiceMetrics,
+		connect.WithSchema(observerServiceGetServiceMetricsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	observerServiceGetTopologyAtHandler := connect.NewUnaryHandler(
+		ObserverServiceGetTopologyAtProcedure,
+		svc.GetTopologyAt,
+		connect.WithSchema(observerServiceGetTopologyAtMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	observerServiceListTopologyChangesHandler := connect.NewUnaryHandler(
+		ObserverServiceListTopologyChangesProcedure,
+		svc.ListTopologyChanges,
+		connect.WithSchema(observerServiceListTopologyChangesMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	observerServiceDiffTopologyHandler := connect.NewUnaryHandler(
+		ObserverServiceDiffTopologyProcedure,
+		svc.DiffTopology,
+		connect.WithSchema(observerServiceDiffTopologyMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	observerServiceListMembersHandler := connect.NewUnaryHandler(
+		ObserverServiceListMembersProcedure,
+		svc.ListMembers,
+		connect.WithSchema(observerServiceListMembersMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/observer.v1.ObserverService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case ObserverServiceGetTopologyProcedure:
@@ -185,6 +403,22 @@ func NewObserverServiceHandler(svc ObserverServiceHandler, opts ...connect.Handl
 			observerServiceGetServiceResourcesHandler.ServeHTTP(w, r)
 		case ObserverServiceGetRequestLogsProcedure:
 			observerServiceGetRequestLogsHandler.ServeHTTP(w, r)
+		case ObserverServiceWatchRequestLogsProcedure:
+			observerServiceWatchRequestLogsHandler.ServeHTTP(w, r)
+		case ObserverServiceGetAggregatedRequestLogsProcedure:
+			observerServiceGetAggregatedRequestLogsHandler.ServeHTTP(w, r)
+		case ObserverServiceSearchRequestLogsProcedure:
+			observerServiceSearchRequestLogsHandler.ServeHTTP(w, r)
+		case ObserverServiceGetServiceMetricsProcedure:
+			observerServiceGetServiceMetricsHandler.ServeHTTP(w, r)
+		case ObserverServiceGetTopologyAtProcedure:
+			observerServiceGetTopologyAtHandler.ServeHTTP(w, r)
+		case ObserverServiceListTopologyChangesProcedure:
+			observerServiceListTopologyChangesHandler.ServeHTTP(w, r)
+		case ObserverServiceDiffTopologyProcedure:
+			observerServiceDiffTopologyHandler.ServeHTTP(w, r)
+		case ObserverServiceListMembersProcedure:
+			observerServiceListMembersHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -209,3 +443,35 @@ func (UnimplementedObserverServiceHandler) GetServiceResources(context.Context,
 func (UnimplementedObserverServiceHandler) GetRequestLogs(context.Context, *connect.Request[v1.GetRequestLogsRequest]) (*connect.Response[v1.GetRequestLogsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("observer.v1.ObserverService.GetRequestLogs is not implemented"))
 }
+
+func (UnimplementedObserverServiceHandler) WatchRequestLogs(context.Context, *connect.Request[v1.WatchRequestLogsRequest], *connect.ServerStream[v1.WatchRequestLogsResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("observer.v1.ObserverService.WatchRequestLogs is not implemented"))
+}
+
+func (UnimplementedObserverServiceHandler) GetAggregatedRequestLogs(context.Context, *connect.Request[v1.GetAggregatedRequestLogsRequest]) (*connect.Response[v1.GetAggregatedRequestLogsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("observer.v1.ObserverService.GetAggregatedRequestLogs is not implemented"))
+}
+
+func (UnimplementedObserverServiceHandler) SearchRequestLogs(context.Context, *connect.Request[v1.SearchRequestLogsRequest]) (*connect.Response[v1.SearchRequestLogsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("observer.v1.ObserverService.SearchRequestLogs is not implemented"))
+}
+
+func (UnimplementedObserverServiceHandler) GetServiceMetrics(context.Context, *connect.Request[v1.GetServiceMetricsRequest]) (*connect.Response[v1.GetServiceMetricsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("observer.v1.ObserverService.GetServiceMetrics is not implemented"))
+}
+
+func (UnimplementedObserverServiceHandler) GetTopologyAt(context.Context, *connect.Request[v1.GetTopologyAtRequest]) (*connect.Response[v1.GetTopologyAtResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("observer.v1.ObserverService.GetTopologyAt is not implemented"))
+}
+
+func (UnimplementedObserverServiceHandler) ListTopologyChanges(context.Context, *connect.Request[v1.ListTopologyChangesRequest]) (*connect.Response[v1.ListTopologyChangesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("observer.v1.ObserverService.ListTopologyChanges is not implemented"))
+}
+
+func (UnimplementedObserverServiceHandler) DiffTopology(context.Context, *connect.Request[v1.DiffTopologyRequest]) (*connect.Response[v1.DiffTopologyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("observer.v1.ObserverService.DiffTopology is not implemented"))
+}
+
+func (UnimplementedObserverServiceHandler) ListMembers(context.Context, *connect.Request[v1.ListMembersRequest]) (*connect.Response[v1.ListMembersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("observer.v1.ObserverService.ListMembers is not implemented"))
+}
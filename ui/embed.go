@@ -0,0 +1,24 @@
+// Package ui embeds the production build of the Lattice web UI.
+//
+// Run `npm run build` in this directory before building the Go binary to
+// include the UI. Without a build only dist/.gitkeep is embedded and the
+// server reports that the UI is unavailable.
+package ui
+
+import (
+	"embed"
+	"io/fs"
+)
+
+//go:embed all:dist
+var dist embed.FS
+
+// Dist returns the contents of the dist directory
+func Dist() fs.FS {
+	sub, err := fs.Sub(dist, "dist")
+	if err != nil {
+		// dist is embedded at compile time, so this cannot fail
+		panic(err)
+	}
+	return sub
+}
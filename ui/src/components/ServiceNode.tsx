@@ -23,6 +23,12 @@ function getStatusColor(status: ServiceStatus): {
         border: 'border-norn-green',
         text: 'text-norn-green',
       };
+    case Status.DEGRADED:
+      return {
+        bg: 'bg-amber-400/10',
+        border: 'border-amber-400',
+        text: 'text-amber-400',
+      };
     case Status.UNHEALTHY:
       return {
         bg: 'bg-red-500/10',
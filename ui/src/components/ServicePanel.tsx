@@ -23,10 +23,12 @@ interface ServicePanelProps {
 /**
  * Get status badge color
  */
-function getStatusBadgeColor(status: number): 'green' | 'red' | 'zinc' {
+function getStatusBadgeColor(status: number): 'green' | 'amber' | 'red' | 'zinc' {
   switch (status) {
     case ServiceStatus.HEALTHY:
       return 'green';
+    case ServiceStatus.DEGRADED:
+      return 'amber';
     case ServiceStatus.UNHEALTHY:
       return 'red';
     default:
@@ -41,6 +43,8 @@ function getStatusLabel(status: number): string {
   switch (status) {
     case ServiceStatus.HEALTHY:
       return 'Healthy';
+    case ServiceStatus.DEGRADED:
+      return 'Degraded';
     case ServiceStatus.UNHEALTHY:
       return 'Unhealthy';
     case ServiceStatus.UNKNOWN:
@@ -59,8 +63,7 @@ function RequestLogsView({ serviceName }: { serviceName: string }) {
 
   const { data, isLoading, error } = useRequestLogs({
     serviceName,
-    enabled: autoRefresh, // Only poll when auto-refresh is on
-    refetchInterval: autoRefresh ? 2000 : false, // Poll every 2s when enabled
+    enabled: autoRefresh, // Only stream when auto-refresh is on
     limit: 50, // Show last 50 requests
   });
 
@@ -432,6 +435,13 @@ export function ServicePanel({ service, open, onClose }: ServicePanelProps) {
                                   <DescriptionDetails>{service.nodeName}</DescriptionDetails>
                                 </>
                               )}
+
+                              {service.statusReason && (
+                                <>
+                                  <DescriptionTerm>Status Reason</DescriptionTerm>
+                                  <DescriptionDetails>{service.statusReason}</DescriptionDetails>
+                                </>
+                              )}
                             </DescriptionList>
                           </div>
 
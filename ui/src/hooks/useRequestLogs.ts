@@ -1,4 +1,4 @@
-import { useQuery } from '@tanstack/react-query';
+import { useEffect, useState } from 'react';
 import { createConnectTransport } from '@connectrpc/connect-web';
 import { createClient } from '@connectrpc/connect';
 import { ObserverService } from '../gen/observer/v1/observer_pb';
@@ -10,12 +10,13 @@ const transport = createConnectTransport({
 
 const client = createClient(ObserverService, transport);
 
+// Delay before reconnecting after the stream fails
+const RECONNECT_DELAY_MS = 2000;
+
 interface UseRequestLogsOptions {
   serviceName: string;
-  afterSequence?: bigint;
   limit?: number;
   enabled?: boolean;
-  refetchInterval?: number | false;
 }
 
 interface RequestLogsResult {
@@ -23,28 +24,75 @@ interface RequestLogsResult {
   latestSequence: bigint;
 }
 
+/**
+ * Hook to stream request logs for a service
+ * Uses the WatchRequestLogs streaming RPC and keeps the most recent `limit`
+ * logs. After a dropped connection it resumes from the last sequence seen.
+ */
 export function useRequestLogs({
   serviceName,
-  afterSequence = 0n,
   limit = 100,
   enabled = true,
-  refetchInterval = 2000, // Poll every 2 seconds
 }: UseRequestLogsOptions) {
-  return useQuery<RequestLogsResult>({
-    queryKey: ['requestLogs', serviceName, afterSequence.toString()],
-    queryFn: async () => {
-      const response = await client.getRequestLogs({
-        serviceName,
-        afterSequence,
-        limit,
-      });
-
-      return {
-        logs: response.logs,
-        latestSequence: response.latestSequence,
-      };
-    },
-    enabled: enabled && serviceName !== '',
-    refetchInterval, // Auto-refresh to get new logs
-  });
+  const [data, setData] = useState<RequestLogsResult | undefined>(undefined);
+  const [isLoading, setIsLoading] = useState(true);
+  const [error, setError] = useState<Error | null>(null);
+
+  // Start over when switching services
+  useEffect(() => {
+    setData(undefined);
+    setIsLoading(true);
+    setError(null);
+  }, [serviceName]);
+
+  useEffect(() => {
+    if (!enabled || serviceName === '') {
+      return;
+    }
+
+    const abort = new AbortController();
+    let afterSequence = 0n;
+    let logs: RequestLog[] = [];
+
+    async function watch() {
+      while (!abort.signal.aborted) {
+        try {
+          const stream = client.watchRequestLogs(
+            { serviceName, afterSequence },
+            { signal: abort.signal },
+          );
+
+          for await (const update of stream) {
+            if (update.restarted) {
+              logs = [];
+            }
+            logs = [...logs, ...update.logs].slice(-limit);
+            if (update.logs.length > 0) {
+              afterSequence = update.logs[update.logs.length - 1].sequence;
+            }
+
+            setData({ logs, latestSequence: update.latestSequence });
+            setIsLoading(false);
+            setError(null);
+          }
+        } catch (err) {
+          if (abort.signal.aborted) return;
+          console.error('Request log stream error:', err);
+          setError(err instanceof Error ? err : new Error('Unknown error'));
+          setIsLoading(false);
+        }
+
+        // Reconnect and resume after the last sequence seen
+        await new Promise((resolve) => setTimeout(resolve, RECONNECT_DELAY_MS));
+      }
+    }
+
+    watch();
+
+    return () => {
+      abort.abort();
+    };
+  }, [serviceName, limit, enabled]);
+
+  return { data, isLoading, error };
 }
@@ -1,8 +1,8 @@
 import { useEffect, useState } from 'react';
 import { createClient } from '@connectrpc/connect';
 import { createConnectTransport } from '@connectrpc/connect-web';
-import { ObserverService } from '../gen/observer/v1/observer_pb';
-import type { Topology, Service } from '../gen/observer/v1/observer_pb';
+import { ObserverService, UpdateType } from '../gen/observer/v1/observer_pb';
+import type { Topology, TopologyDelta, Service } from '../gen/observer/v1/observer_pb';
 
 export interface UseTopologyResult {
   topology: Topology | null;
@@ -11,9 +11,43 @@ export interface UseTopologyResult {
   error: Error | null;
 }
 
+/**
+ * Key identifying a service across updates (names are unique per node)
+ */
+function serviceKey(service: Service): string {
+  return `${service.nodeName}/${service.name}`;
+}
+
+/**
+ * Apply an incremental update to a topology. Unchanged services keep their
+ * object identity so memoized components skip re-rendering.
+ */
+function applyDelta(topology: Topology, delta: TopologyDelta): Topology {
+  const services = new Map(topology.services.map((svc) => [serviceKey(svc), svc]));
+
+  for (const svc of delta.removed) {
+    services.delete(serviceKey(svc));
+  }
+  for (const svc of delta.added) {
+    services.set(serviceKey(svc), svc);
+  }
+  for (const change of delta.changed) {
+    if (change.service) {
+      services.set(serviceKey(change.service), change.service);
+    }
+  }
+
+  return {
+    ...topology,
+    services: Array.from(services.values()),
+    timestamp: delta.timestamp,
+  };
+}
+
 /**
  * Hook to stream topology updates from Lattice
- * Uses WatchTopology streaming RPC for real-time updates
+ * Uses WatchTopology streaming RPC for real-time updates: a full snapshot
+ * first, then incremental deltas
  */
 export function useTopology(): UseTopologyResult {
   const [topology, setTopology] = useState<Topology | null>(null);
@@ -32,15 +66,22 @@ export function useTopology(): UseTopologyResult {
     async function streamTopology() {
       try {
         // Start streaming topology updates
-        const stream = client.watchTopology({});
+        const stream = client.watchTopology({ incremental: true });
+        let current: Topology | null = null;
 
         for await (const update of stream) {
           if (aborted) break;
 
-          if (update.topology) {
-            setTopology(update.topology);
-            setIsLoading(false);
+          if (update.updateType === UpdateType.FULL && update.topology) {
+            current = update.topology;
+          } else if (update.updateType === UpdateType.INCREMENTAL && update.delta && current) {
+            current = applyDelta(current, update.delta);
+          } else {
+            continue;
           }
+
+          setTopology(current);
+          setIsLoading(false);
         }
       } catch (err) {
         if (!aborted) {